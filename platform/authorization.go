@@ -0,0 +1,209 @@
+package platform
+
+import (
+	"context"
+	"time"
+)
+
+// Action is an operation a Permission grants against a resource.
+type Action string
+
+// Actions known to the permission system.
+const (
+	ReadAction  Action = "read"
+	WriteAction Action = "write"
+
+	// AllActions is a sentinel Action for a Permission that grants every
+	// action (read, write, and any added later) against its Resource,
+	// intended for admin-style grants. It's matched specially by
+	// Permission.Allows; it is never itself the requested Action of a
+	// permission check, and UserResourceMapping.ToPermissions never emits
+	// it, since every mapping-derived permission is scoped to plain read
+	// or write.
+	AllActions Action = "*"
+)
+
+// Valid returns an EInvalid *Error if a isn't a known Action, so
+// ParsePermission and other call sites can share one place to check it
+// instead of duplicating the switch.
+func (a Action) Valid() error {
+	switch a {
+	case ReadAction, WriteAction, AllActions:
+		return nil
+	default:
+		return &Error{
+			Code: EInvalid,
+			Msg:  "unknown action: " + string(a),
+			Op:   "Action.Valid",
+		}
+	}
+}
+
+// PermissionResource identifies the resource(s) a Permission applies to. A
+// nil ID scopes the permission to every resource of Type within OrgID (or
+// every organization, if OrgID is also nil).
+type PermissionResource struct {
+	Type  ResourceType
+	ID    *ID
+	OrgID *ID
+}
+
+// Valid reports whether r names a known resource type.
+func (r PermissionResource) Valid() bool {
+	return r.Type.Valid()
+}
+
+// Permission grants Action against Resource.
+type Permission struct {
+	Action   Action
+	Resource PermissionResource
+}
+
+// Valid reports whether p has a known Action and a Resource naming a known
+// resource type.
+func (p Permission) Valid() bool {
+	return p.Action.Valid() == nil && p.Resource.Valid()
+}
+
+// String renders p as "<action>:<resourceType>[:<id>]", e.g.
+// "write:bucket:0000000000000001", for logging and test assertions.
+func (p Permission) String() string {
+	s := string(p.Action) + ":" + string(p.Resource.Type)
+	if p.Resource.ID != nil {
+		s += ":" + p.Resource.ID.String()
+	}
+	return s
+}
+
+// Allows reports whether p, as a granted Permission, satisfies a request
+// for requested: p.Action is AllActions or equals requested's Action, p's
+// resource Type matches requested's, and requested's OrgID/ID (if set)
+// fall within whatever p scopes to. A nil Resource.OrgID or Resource.ID on
+// p means "every org" or "every resource of this type" respectively, so it
+// allows any requested value there.
+func (p Permission) Allows(requested Permission) bool {
+	if p.Action != AllActions && p.Action != requested.Action {
+		return false
+	}
+	if p.Resource.Type != requested.Resource.Type {
+		return false
+	}
+	if p.Resource.OrgID != nil && (requested.Resource.OrgID == nil || *p.Resource.OrgID != *requested.Resource.OrgID) {
+		return false
+	}
+	if p.Resource.ID != nil && (requested.Resource.ID == nil || *p.Resource.ID != *requested.Resource.ID) {
+		return false
+	}
+	return true
+}
+
+// PermissionsDiff compares before and after and returns the permissions
+// added (present in after but not before) and removed (present in before
+// but not after), by exact equality. Order within added/removed follows
+// after's and before's order respectively; a permission repeated in both
+// slices contributes to the diff once per unmatched occurrence.
+func PermissionsDiff(before, after []Permission) (added, removed []Permission) {
+	beforeCount := make(map[Permission]int, len(before))
+	for _, p := range before {
+		beforeCount[p]++
+	}
+	afterCount := make(map[Permission]int, len(after))
+	for _, p := range after {
+		afterCount[p]++
+	}
+
+	for _, p := range after {
+		if beforeCount[p] > 0 {
+			beforeCount[p]--
+			continue
+		}
+		added = append(added, p)
+	}
+	for _, p := range before {
+		if afterCount[p] > 0 {
+			afterCount[p]--
+			continue
+		}
+		removed = append(removed, p)
+	}
+	return added, removed
+}
+
+// NewPermissionAtID returns a Permission granting action against the
+// single resource of type rt identified by id.
+func NewPermissionAtID(id ID, action Action, rt ResourceType) Permission {
+	return Permission{
+		Action: action,
+		Resource: PermissionResource{
+			Type: rt,
+			ID:   &id,
+		},
+	}
+}
+
+// AuthorizationStatus is whether an Authorization is currently usable.
+type AuthorizationStatus string
+
+// Authorization statuses.
+const (
+	Active   AuthorizationStatus = "active"
+	Inactive AuthorizationStatus = "inactive"
+)
+
+// Authorization is a token granting its holder a fixed set of permissions
+// on behalf of a user.
+type Authorization struct {
+	ID             ID
+	Token          string
+	UserID         ID
+	OrganizationID ID
+	Status         AuthorizationStatus
+	Permissions    []Permission
+	CreatedAt      time.Time
+	// ExpiresAt, if set, is when the authorization stops granting
+	// permissions regardless of Status.
+	ExpiresAt *time.Time
+}
+
+// Allowed reports whether a currently grants p: an Inactive authorization,
+// or one whose ExpiresAt has passed, denies every permission regardless of
+// what Permissions lists; otherwise it's granted if any entry in
+// Permissions allows p.
+func (a *Authorization) Allowed(p Permission) bool {
+	if a.Status != Active {
+		return false
+	}
+	if a.ExpiresAt != nil && time.Now().After(*a.ExpiresAt) {
+		return false
+	}
+	for _, granted := range a.Permissions {
+		if granted.Allows(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthorizationFilter is a set of criteria for filtering authorizations.
+// Every non-nil field must match (AND).
+type AuthorizationFilter struct {
+	ID     *ID
+	UserID *ID
+	OrgID  *ID
+	Token  *string
+}
+
+// AuthorizationService represents a service for managing authorization
+// data.
+type AuthorizationService interface {
+	FindAuthorizationByID(ctx context.Context, id ID) (*Authorization, error)
+	// FindAuthorizationByToken returns the authorization with the given
+	// Token, or ENotFound if no authorization has it.
+	FindAuthorizationByToken(ctx context.Context, token string) (*Authorization, error)
+	FindAuthorizations(ctx context.Context, filter AuthorizationFilter) ([]*Authorization, error)
+	CreateAuthorization(ctx context.Context, a *Authorization) error
+	// UpdateAuthorizationStatus sets an authorization's status (e.g. to
+	// revoke it without deleting its record).
+	UpdateAuthorizationStatus(ctx context.Context, id ID, status AuthorizationStatus) (*Authorization, error)
+	DeleteAuthorization(ctx context.Context, id ID) error
+}
@@ -0,0 +1,51 @@
+package platform_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestAuthorization_Allowed(t *testing.T) {
+	bucketID := platform.ID(1)
+	perm := platform.NewPermissionAtID(bucketID, platform.WriteAction, platform.BucketResourceType)
+
+	active := &platform.Authorization{Status: platform.Active, Permissions: []platform.Permission{perm}}
+	if !active.Allowed(perm) {
+		t.Fatalf("active authorization with matching permission should be allowed")
+	}
+
+	inactive := &platform.Authorization{Status: platform.Inactive, Permissions: []platform.Permission{perm}}
+	if inactive.Allowed(perm) {
+		t.Fatalf("inactive authorization must deny a permission it would otherwise grant")
+	}
+
+	missing := &platform.Authorization{Status: platform.Active}
+	if missing.Allowed(perm) {
+		t.Fatalf("authorization with no matching permission should be denied")
+	}
+
+	past := time.Now().Add(-time.Hour)
+	expired := &platform.Authorization{Status: platform.Active, Permissions: []platform.Permission{perm}, ExpiresAt: &past}
+	if expired.Allowed(perm) {
+		t.Fatalf("expired authorization must deny a permission it would otherwise grant")
+	}
+}
+
+func TestPermission_Allows_AllActions(t *testing.T) {
+	bucketID := platform.ID(1)
+	read := platform.NewPermissionAtID(bucketID, platform.ReadAction, platform.BucketResourceType)
+	write := platform.NewPermissionAtID(bucketID, platform.WriteAction, platform.BucketResourceType)
+	all := platform.NewPermissionAtID(bucketID, platform.AllActions, platform.BucketResourceType)
+
+	if !all.Allows(read) {
+		t.Fatalf("an AllActions grant should allow a read request")
+	}
+	if !all.Allows(write) {
+		t.Fatalf("an AllActions grant should allow a write request")
+	}
+	if read.Allows(write) {
+		t.Fatalf("a read grant should not allow a write request")
+	}
+}
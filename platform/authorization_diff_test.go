@@ -0,0 +1,39 @@
+package platform_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestPermissionsDiff(t *testing.T) {
+	bucketID := platform.ID(1)
+	dashboardID := platform.ID(2)
+	orgID := platform.ID(3)
+
+	read := platform.NewPermissionAtID(bucketID, platform.ReadAction, platform.BucketResourceType)
+	write := platform.NewPermissionAtID(bucketID, platform.WriteAction, platform.BucketResourceType)
+	unchanged := platform.NewPermissionAtID(dashboardID, platform.ReadAction, platform.DashboardResourceType)
+	added := platform.NewPermissionAtID(orgID, platform.WriteAction, platform.OrganizationResourceType)
+
+	before := []platform.Permission{read, unchanged}
+	after := []platform.Permission{write, unchanged, added}
+
+	gotAdded, gotRemoved := platform.PermissionsDiff(before, after)
+
+	if !reflect.DeepEqual(gotAdded, []platform.Permission{write, added}) {
+		t.Fatalf("added = %v, want [%v %v]", gotAdded, write, added)
+	}
+	if !reflect.DeepEqual(gotRemoved, []platform.Permission{read}) {
+		t.Fatalf("removed = %v, want [%v]", gotRemoved, read)
+	}
+}
+
+func TestPermissionsDiff_Identical(t *testing.T) {
+	perm := platform.NewPermissionAtID(platform.ID(1), platform.ReadAction, platform.BucketResourceType)
+	added, removed := platform.PermissionsDiff([]platform.Permission{perm}, []platform.Permission{perm})
+	if len(added) != 0 || len(removed) != 0 {
+		t.Fatalf("got added=%v removed=%v, want both empty", added, removed)
+	}
+}
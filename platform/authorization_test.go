@@ -0,0 +1,39 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestAction_Valid(t *testing.T) {
+	tests := []struct {
+		action platform.Action
+		valid  bool
+	}{
+		{platform.ReadAction, true},
+		{platform.WriteAction, true},
+		{platform.Action("delete"), false},
+		{platform.Action(""), false},
+	}
+
+	for _, tt := range tests {
+		err := tt.action.Valid()
+		if (err == nil) != tt.valid {
+			t.Errorf("Action(%q).Valid() = %v, want valid=%v", tt.action, err, tt.valid)
+		}
+		if err != nil && platform.ErrorCode(err) != platform.EInvalid {
+			t.Errorf("Action(%q).Valid() code = %v, want EInvalid", tt.action, platform.ErrorCode(err))
+		}
+	}
+}
+
+func TestPermission_Valid_DelegatesToAction(t *testing.T) {
+	p := platform.Permission{
+		Action:   platform.Action("delete"),
+		Resource: platform.PermissionResource{Type: platform.OrganizationResourceType},
+	}
+	if p.Valid() {
+		t.Fatal("expected Permission with unknown action to be invalid")
+	}
+}
@@ -0,0 +1,29 @@
+package platform
+
+import (
+	"context"
+	"time"
+)
+
+// PermissionsForAuthorizations returns the union of permissions granted by
+// every currently-usable authorization belonging to userID: it skips
+// authorizations that aren't Active and those whose ExpiresAt has passed.
+func PermissionsForAuthorizations(ctx context.Context, s AuthorizationService, userID ID) ([]Permission, error) {
+	auths, err := s.FindAuthorizations(ctx, AuthorizationFilter{UserID: &userID})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var perms []Permission
+	for _, a := range auths {
+		if a.Status != Active {
+			continue
+		}
+		if a.ExpiresAt != nil && now.After(*a.ExpiresAt) {
+			continue
+		}
+		perms = append(perms, a.Permissions...)
+	}
+	return perms, nil
+}
@@ -0,0 +1,93 @@
+package platform_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestPermissionsForAuthorizations(t *testing.T) {
+	ctx := context.Background()
+	s := inmem.NewService()
+
+	userID := platform.ID(1)
+	otherUserID := platform.ID(2)
+
+	activePerm := platform.Permission{Action: platform.ReadAction, Resource: platform.PermissionResource{Type: platform.DashboardResourceType}}
+	inactivePerm := platform.Permission{Action: platform.WriteAction, Resource: platform.PermissionResource{Type: platform.DashboardResourceType}}
+	expiredPerm := platform.Permission{Action: platform.WriteAction, Resource: platform.PermissionResource{Type: platform.BucketResourceType}}
+	otherUserPerm := platform.Permission{Action: platform.WriteAction, Resource: platform.PermissionResource{Type: platform.OrganizationResourceType}}
+
+	if err := s.CreateAuthorization(ctx, &platform.Authorization{
+		UserID:      userID,
+		Status:      platform.Active,
+		Permissions: []platform.Permission{activePerm},
+	}); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+	if err := s.CreateAuthorization(ctx, &platform.Authorization{
+		UserID:      userID,
+		Status:      platform.Inactive,
+		Permissions: []platform.Permission{inactivePerm},
+	}); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+	expiredAt := time.Now().Add(-time.Hour)
+	if err := s.CreateAuthorization(ctx, &platform.Authorization{
+		UserID:      userID,
+		Status:      platform.Active,
+		ExpiresAt:   &expiredAt,
+		Permissions: []platform.Permission{expiredPerm},
+	}); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+	if err := s.CreateAuthorization(ctx, &platform.Authorization{
+		UserID:      otherUserID,
+		Status:      platform.Active,
+		Permissions: []platform.Permission{otherUserPerm},
+	}); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+
+	perms, err := platform.PermissionsForAuthorizations(ctx, s, userID)
+	if err != nil {
+		t.Fatalf("PermissionsForAuthorizations: %v", err)
+	}
+
+	if len(perms) != 1 || perms[0] != activePerm {
+		t.Fatalf("got %+v, want only %+v", perms, activePerm)
+	}
+}
+
+func TestPermissionsForAuthorizations_NoAuthorizations(t *testing.T) {
+	ctx := context.Background()
+	s := inmem.NewService()
+
+	perms, err := platform.PermissionsForAuthorizations(ctx, s, platform.ID(1))
+	if err != nil {
+		t.Fatalf("PermissionsForAuthorizations: %v", err)
+	}
+	if len(perms) != 0 {
+		t.Fatalf("got %+v, want none", perms)
+	}
+}
+
+func TestPermissionValid(t *testing.T) {
+	valid := platform.Permission{Action: platform.ReadAction, Resource: platform.PermissionResource{Type: platform.DashboardResourceType}}
+	if !valid.Valid() {
+		t.Errorf("expected %+v to be Valid", valid)
+	}
+
+	badAction := platform.Permission{Action: "delete", Resource: platform.PermissionResource{Type: platform.DashboardResourceType}}
+	if badAction.Valid() {
+		t.Errorf("expected %+v to be invalid: unknown action", badAction)
+	}
+
+	badResource := platform.Permission{Action: platform.ReadAction, Resource: platform.PermissionResource{Type: "bogus"}}
+	if badResource.Valid() {
+		t.Errorf("expected %+v to be invalid: unknown resource type", badResource)
+	}
+}
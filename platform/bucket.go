@@ -0,0 +1,77 @@
+package platform
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Bucket is a named location where time series data is stored.
+type Bucket struct {
+	ID              ID
+	OrganizationID  ID
+	Name            string
+	RetentionPeriod time.Duration
+}
+
+// Valid reports every field-level problem with b at once, rather than
+// stopping at the first one. It returns an EInvalid *Error whose Msg lists
+// each invalid field as "field: problem", joined by "; ", or nil if b is
+// valid.
+func (b *Bucket) Valid() error {
+	var problems []string
+
+	if b.Name == "" {
+		problems = append(problems, "name: required")
+	} else if len(b.Name) > MaxNameLength {
+		problems = append(problems, "name: exceeds maximum length")
+	}
+
+	if b.RetentionPeriod < 0 {
+		problems = append(problems, "retentionPeriod: must not be negative")
+	}
+
+	if !b.OrganizationID.Valid() {
+		problems = append(problems, "organizationID: required")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &Error{
+		Code: EInvalid,
+		Msg:  strings.Join(problems, "; "),
+		Op:   "Bucket.Valid",
+	}
+}
+
+// BucketFilter is a set of criteria for filtering buckets. Every non-nil
+// field must match (AND).
+type BucketFilter struct {
+	OrganizationID *ID
+	Name           *string
+	// CaseInsensitive, if set, matches Name case-insensitively. It has no
+	// effect if Name is nil.
+	CaseInsensitive bool
+}
+
+// BucketUpdate describes a partial update to a Bucket. A nil field means
+// "leave unchanged."
+type BucketUpdate struct {
+	Name            *string
+	RetentionPeriod *time.Duration
+}
+
+// BucketService represents a service for managing bucket data.
+type BucketService interface {
+	FindBucketByID(ctx context.Context, id ID) (*Bucket, error)
+	// FindBuckets returns the buckets matching filter, along with the total
+	// number that matched before opts.Limit/Offset were applied. A filter
+	// naming an organization with no buckets returns an empty slice, not an
+	// error.
+	FindBuckets(ctx context.Context, filter BucketFilter, opts FindOptions) ([]*Bucket, int, error)
+	CreateBucket(ctx context.Context, b *Bucket) error
+	// UpdateBucket applies upd to the bucket with the given ID.
+	UpdateBucket(ctx context.Context, id ID, upd BucketUpdate) (*Bucket, error)
+	DeleteBucket(ctx context.Context, id ID) error
+}
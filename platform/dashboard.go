@@ -0,0 +1,120 @@
+package platform
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Dashboard is a user-created collection of cells for visualizing data.
+type Dashboard struct {
+	ID             ID
+	OrganizationID ID
+	Name           string
+	Description    string
+	Cells          []*Cell
+	Meta           DashboardMeta
+}
+
+// Clone returns a deep copy of d: Meta and each Cell are copied rather than
+// shared, so a caller can freely mutate the clone (or its cells) without
+// touching the original.
+func (d *Dashboard) Clone() *Dashboard {
+	cp := *d
+	cp.Cells = make([]*Cell, len(d.Cells))
+	for i, c := range d.Cells {
+		cellCopy := *c
+		cp.Cells[i] = &cellCopy
+	}
+	if d.Meta.DeletedAt != nil {
+		deletedAt := *d.Meta.DeletedAt
+		cp.Meta.DeletedAt = &deletedAt
+	}
+	return &cp
+}
+
+// DashboardMeta holds metadata about a Dashboard that isn't editable via
+// the API.
+type DashboardMeta struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// DeletedAt is set when the dashboard is soft-deleted (trashed) and
+	// cleared by RestoreDashboard. A soft-deleted dashboard is excluded
+	// from FindDashboards unless DashboardFilter.IncludeDeleted is set, but
+	// remains fetchable by ID until it's purged.
+	DeletedAt *time.Time
+}
+
+// Cell holds positional and content information for a single dashboard
+// visualization.
+type Cell struct {
+	ID     ID
+	ViewID ID
+	CellProperty
+}
+
+// CellProperty describes a cell's position and size on a dashboard.
+type CellProperty struct {
+	X int32
+	Y int32
+	W int32
+	H int32
+}
+
+// DashboardFilter is a set of criteria used to filter dashboards.
+type DashboardFilter struct {
+	IDs            []*ID
+	OrganizationID *ID
+	// Name, if non-empty, matches dashboards whose Name contains it as a
+	// case-insensitive substring.
+	Name string
+	// IncludeDeleted, if set, includes soft-deleted (trashed) dashboards in
+	// the results. Off by default, so routine listings don't surface trash.
+	IncludeDeleted bool
+}
+
+// DashboardUpdate describes a partial update to a Dashboard.
+type DashboardUpdate struct {
+	Name        *string
+	Description *string
+}
+
+// DashboardService represents a service for managing dashboard data.
+type DashboardService interface {
+	FindDashboardByID(ctx context.Context, id ID) (*Dashboard, error)
+	FindDashboards(ctx context.Context, filter DashboardFilter, opts FindOptions) ([]*Dashboard, int, error)
+
+	// StreamDashboards writes every dashboard matching filter to w as
+	// newline-delimited JSON (one object per line), in the same order
+	// FindDashboards would return them with a zero-value FindOptions. It's
+	// meant for exports of large result sets, where materializing a
+	// "{"dashboards":[...]}" array in memory on both ends is wasteful.
+	StreamDashboards(ctx context.Context, filter DashboardFilter, w io.Writer) error
+	CreateDashboard(ctx context.Context, d *Dashboard) error
+	UpdateDashboard(ctx context.Context, id ID, upd DashboardUpdate) (*Dashboard, error)
+
+	// DeleteDashboard soft-deletes a dashboard: it's hidden from
+	// FindDashboards (unless IncludeDeleted is set) but recoverable via
+	// RestoreDashboard until it's purged.
+	DeleteDashboard(ctx context.Context, id ID) error
+	// RestoreDashboard clears a dashboard's soft-delete, making it visible
+	// again in default FindDashboards results.
+	RestoreDashboard(ctx context.Context, id ID) error
+	// PurgeDashboard permanently removes a dashboard and its label
+	// mappings. Unlike DeleteDashboard, this cannot be undone.
+	PurgeDashboard(ctx context.Context, id ID) error
+
+	// FindDashboardsByLabel returns the page of dashboards mapped to labelID
+	// selected by opts, along with the total count of matching dashboards
+	// before paging is applied. It returns an empty slice, not an error, if
+	// no dashboard is mapped to labelID.
+	FindDashboardsByLabel(ctx context.Context, labelID ID, opts FindOptions) ([]*Dashboard, int, error)
+
+	// AddDashboardLabels attaches every label in labelIDs to dashboardID in
+	// one call. It keeps going past an individual label failure (e.g. one
+	// that doesn't exist) and aggregates every error into a MultiError, so
+	// one bad label ID doesn't stop the rest from being attached.
+	// Attaching a label that's already attached is idempotent.
+	AddDashboardLabels(ctx context.Context, dashboardID ID, labelIDs []ID) error
+}
@@ -0,0 +1,34 @@
+package platform_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestDashboard_Clone_MutatingCloneLeavesOriginalUnchanged(t *testing.T) {
+	deletedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &platform.Dashboard{
+		Name: "original",
+		Cells: []*platform.Cell{
+			{ID: platform.ID(1), ViewID: platform.ID(10)},
+		},
+		Meta: platform.DashboardMeta{DeletedAt: &deletedAt},
+	}
+
+	clone := d.Clone()
+	clone.Cells[0].ViewID = platform.ID(99)
+	clone.Cells = append(clone.Cells, &platform.Cell{ID: platform.ID(2)})
+	*clone.Meta.DeletedAt = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if len(d.Cells) != 1 {
+		t.Fatalf("original Cells length changed: got %d, want 1", len(d.Cells))
+	}
+	if d.Cells[0].ViewID != platform.ID(10) {
+		t.Fatalf("original cell mutated: got ViewID %v, want %v", d.Cells[0].ViewID, platform.ID(10))
+	}
+	if !d.Meta.DeletedAt.Equal(deletedAt) {
+		t.Fatalf("original DeletedAt mutated: got %v, want %v", d.Meta.DeletedAt, deletedAt)
+	}
+}
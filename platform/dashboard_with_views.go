@@ -0,0 +1,9 @@
+package platform
+
+// DashboardWithViews is a Dashboard whose cells carry their resolved View
+// alongside the ViewID, for callers that want to render a dashboard
+// without a separate round-trip per view.
+type DashboardWithViews struct {
+	*Dashboard
+	Views map[ID]*View
+}
@@ -0,0 +1,276 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// Error codes used across the platform packages. They form a closed set so
+// HTTP handlers can map errors to consistent status codes without string
+// matching messages.
+const (
+	ENotFound     = "not found"
+	EConflict     = "conflict"
+	EInvalid      = "invalid"
+	EInternal     = "internal error"
+	EUnauthorized = "unauthorized"
+	EUnavailable  = "unavailable"
+
+	// EMethodNotAllowed is returned when a request can't be served in the
+	// form the client asked for, e.g. an unsupported Accept header during
+	// content negotiation.
+	EMethodNotAllowed = "method not allowed"
+
+	// ETooManyRequests is returned when a caller has been rate limited.
+	// Unlike EUnavailable (the server can't help right now), this tells a
+	// caller the failure is on their side of the rate limit and it's safe
+	// to retry with backoff.
+	ETooManyRequests = "too many requests"
+
+	// EClientClosedRequest is returned when a request failed because the
+	// caller's context was cancelled (e.g. the client disconnected or gave
+	// up waiting). It's reported separately from EInternal so middleware
+	// doesn't log a client's own cancellation as a server failure.
+	EClientClosedRequest = "client closed request"
+
+	// ETimeout is returned when a request failed because its context's
+	// deadline was exceeded. Like EClientClosedRequest, this is a
+	// caller-side condition, not a server failure.
+	ETimeout = "timeout"
+)
+
+// Retryable reports whether a caller can reasonably retry the operation
+// that produced err with backoff. Only errors that are transient by nature
+// (rate limiting, general unavailability) are retryable; the rest (not
+// found, invalid input, conflicts, auth failures) will fail again
+// identically on retry.
+func Retryable(err error) bool {
+	switch ErrorCode(err) {
+	case ETooManyRequests, EUnavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// Error is a structured, wrappable error used throughout the platform
+// packages so callers can inspect Code programmatically instead of
+// string-matching Error() output.
+type Error struct {
+	Code string
+	Msg  string
+	Op   string
+	Err  error
+
+	// Stack holds "file:line" frames captured by WithStack. Empty unless
+	// EnableStackTraces was set at the time WithStack was called.
+	Stack []string
+
+	// Fields carries optional machine-readable context (e.g. the
+	// conflicting resource ID) for consumers that want to index on
+	// structured data instead of parsing Msg.
+	Fields map[string]interface{}
+}
+
+// EnableStackTraces, when true, makes WithStack capture caller frames into
+// an Error. Off by default: walking the stack costs a runtime.Callers scan
+// on every construction, which isn't worth paying outside active debugging.
+var EnableStackTraces = false
+
+// stackDepth is the number of caller frames WithStack captures.
+const stackDepth = 8
+
+// WithStack captures a few caller frames into e (skipping WithStack itself)
+// and returns e, so a construction site can opt in with
+// `return (&Error{...}).WithStack()`. It's a no-op unless EnableStackTraces
+// is set.
+func (e *Error) WithStack() *Error {
+	if e == nil || !EnableStackTraces {
+		return e
+	}
+
+	pcs := make([]uintptr, stackDepth)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		e.Stack = append(e.Stack, fmt.Sprintf("%s:%d", frame.File, frame.Line))
+		if !more {
+			break
+		}
+	}
+	return e
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+
+	var b strings.Builder
+	if e.Op != "" {
+		b.WriteString(e.Op)
+		b.WriteString(": ")
+	}
+
+	if e.Err != nil {
+		b.WriteString(e.Err.Error())
+		if e.Msg != "" {
+			b.WriteString(": ")
+		}
+	}
+
+	if e.Msg != "" {
+		b.WriteString(e.Msg)
+	} else if e.Err == nil && e.Code != "" {
+		b.WriteString(e.Code)
+	}
+
+	if len(e.Fields) > 0 {
+		keys := make([]string, 0, len(e.Fields))
+		for k := range e.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		pairs := make([]string, len(keys))
+		for i, k := range keys {
+			pairs[i] = fmt.Sprintf("%s=%v", k, e.Fields[k])
+		}
+		b.WriteString(" [")
+		b.WriteString(strings.Join(pairs, " "))
+		b.WriteString("]")
+	}
+
+	if len(e.Stack) > 0 {
+		b.WriteString(" (")
+		b.WriteString(strings.Join(e.Stack, " <- "))
+		b.WriteString(")")
+	}
+
+	return b.String()
+}
+
+// errorJSON is the wire representation of an Error, used by
+// MarshalJSON/UnmarshalJSON.
+type errorJSON struct {
+	Code    string                 `json:"code,omitempty"`
+	Msg     string                 `json:"message,omitempty"`
+	Op      string                 `json:"op,omitempty"`
+	Err     string                 `json:"error,omitempty"`
+	Stack   []string               `json:"stack,omitempty"`
+	Context map[string]interface{} `json:"context,omitempty"`
+}
+
+// MarshalJSON encodes e, flattening the wrapped Err (if any) down to its
+// message since error values themselves aren't serializable.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	j := errorJSON{Code: e.Code, Msg: e.Msg, Op: e.Op, Stack: e.Stack, Context: e.Fields}
+	if e.Err != nil {
+		j.Err = e.Err.Error()
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes e from the shape written by MarshalJSON. The
+// wrapped Err is restored as a plain *errors.New-style error carrying just
+// the flattened message, since the original error's type is lost once
+// serialized.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var j errorJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	e.Code = j.Code
+	e.Msg = j.Msg
+	e.Op = j.Op
+	e.Stack = j.Stack
+	e.Fields = j.Context
+	if j.Err != "" {
+		e.Err = errors.New(j.Err)
+	} else {
+		e.Err = nil
+	}
+	return nil
+}
+
+// ErrorCode returns the code of the first *Error found in err's chain, or
+// EInternal if err is a non-nil error that isn't a *Error. context.Canceled
+// and context.DeadlineExceeded (whether passed directly or wrapped as the
+// Err of a *Error) map to EClientClosedRequest and ETimeout respectively,
+// rather than EInternal, so a client cancellation or timeout isn't reported
+// as a server failure.
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return EClientClosedRequest
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ETimeout
+	}
+
+	e, ok := err.(*Error)
+	if !ok {
+		return EInternal
+	}
+
+	if e.Code == "" && e.Err != nil {
+		return ErrorCode(e.Err)
+	}
+
+	return e.Code
+}
+
+// ErrorMessage returns the human-readable message of the first *Error found
+// in err's chain, or err.Error() if err isn't a *Error.
+func ErrorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	e, ok := err.(*Error)
+	if !ok {
+		return err.Error()
+	}
+
+	if e.Msg == "" && e.Err != nil {
+		return ErrorMessage(e.Err)
+	}
+
+	return e.Msg
+}
+
+// MultiError aggregates the errors from a best-effort operation (e.g. a
+// cascade delete) that keeps going past an individual failure instead of
+// stopping at the first one.
+type MultiError []error
+
+// Error implements the error interface, joining every aggregated error's
+// message.
+func (m MultiError) Error() string {
+	msgs := make([]string, len(m))
+	for i, err := range m {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ErrOrNil returns m as an error if it has any elements, or nil otherwise,
+// so callers can end a best-effort loop with `return errs.ErrOrNil()`
+// instead of an explicit length check.
+func (m MultiError) ErrOrNil() error {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
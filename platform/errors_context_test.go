@@ -0,0 +1,30 @@
+package platform_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestErrorCode_ContextErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"bare Canceled", context.Canceled, platform.EClientClosedRequest},
+		{"bare DeadlineExceeded", context.DeadlineExceeded, platform.ETimeout},
+		{"Canceled wrapped in Error", &platform.Error{Op: "op", Err: context.Canceled}, platform.EClientClosedRequest},
+		{"DeadlineExceeded wrapped in Error", &platform.Error{Op: "op", Err: context.DeadlineExceeded}, platform.ETimeout},
+		{"explicit Code wins over wrapped Canceled", &platform.Error{Code: platform.EInvalid, Err: context.Canceled}, platform.EInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := platform.ErrorCode(tt.err); got != tt.want {
+				t.Errorf("ErrorCode(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
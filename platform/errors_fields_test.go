@@ -0,0 +1,45 @@
+package platform_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestError_FieldsRoundTrip(t *testing.T) {
+	orig := &platform.Error{
+		Code:   platform.EConflict,
+		Msg:    "name already exists",
+		Op:     "inmem/CreateBucket",
+		Fields: map[string]interface{}{"resourceID": "0000000000000001", "attempt": float64(2)},
+	}
+
+	data, err := json.Marshal(orig)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `"context"`) {
+		t.Fatalf("expected fields under \"context\" key, got: %s", data)
+	}
+
+	var got platform.Error
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if got.Code != orig.Code || got.Msg != orig.Msg || got.Op != orig.Op {
+		t.Fatalf("got %+v, want %+v", got, orig)
+	}
+	if got.Fields["resourceID"] != "0000000000000001" || got.Fields["attempt"] != float64(2) {
+		t.Fatalf("Fields not round-tripped: %+v", got.Fields)
+	}
+}
+
+func TestError_ErrorStringIncludesFields(t *testing.T) {
+	err := &platform.Error{Msg: "conflict", Fields: map[string]interface{}{"id": "abc"}}
+	if !strings.Contains(err.Error(), "id=abc") {
+		t.Fatalf("expected error string to include field, got: %s", err.Error())
+	}
+}
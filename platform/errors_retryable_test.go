@@ -0,0 +1,29 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{platform.ETooManyRequests, true},
+		{platform.EUnavailable, true},
+		{platform.ENotFound, false},
+		{platform.EInvalid, false},
+		{platform.EConflict, false},
+		{platform.EUnauthorized, false},
+		{platform.EInternal, false},
+	}
+
+	for _, tt := range tests {
+		err := &platform.Error{Code: tt.code}
+		if got := platform.Retryable(err); got != tt.want {
+			t.Errorf("Retryable(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
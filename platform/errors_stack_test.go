@@ -0,0 +1,34 @@
+package platform_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestError_WithStack(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		platform.EnableStackTraces = false
+		err := (&platform.Error{Code: platform.EInternal, Msg: "boom"}).WithStack()
+		if strings.Contains(err.Error(), ".go:") {
+			t.Fatalf("expected no frame in error string when disabled, got: %s", err.Error())
+		}
+		if len(err.Stack) != 0 {
+			t.Fatalf("expected empty Stack when disabled, got %v", err.Stack)
+		}
+	})
+
+	t.Run("enabled captures a frame", func(t *testing.T) {
+		platform.EnableStackTraces = true
+		defer func() { platform.EnableStackTraces = false }()
+
+		err := (&platform.Error{Code: platform.EInternal, Msg: "boom"}).WithStack()
+		if !strings.Contains(err.Error(), ".go:") {
+			t.Fatalf("expected a file:line frame in error string, got: %s", err.Error())
+		}
+		if len(err.Stack) == 0 {
+			t.Fatal("expected non-empty Stack when enabled")
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package platform
+
+// FindOptions represents options passed to finder methods to sort and page
+// results.
+type FindOptions struct {
+	Limit  int
+	Offset int
+	SortBy string
+
+	// Descending reverses the sort order given by SortBy. Ties still fall
+	// back to ID ascending, regardless of Descending, for deterministic
+	// paging.
+	Descending bool
+}
+
+// Valid returns an EInvalid error if o has a negative Limit or Offset. A
+// Limit of zero means "no limit" and is valid.
+func (o FindOptions) Valid() error {
+	if o.Limit < 0 {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "limit must be non-negative",
+			Op:   "FindOptions.Valid",
+		}
+	}
+	if o.Offset < 0 {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "offset must be non-negative",
+			Op:   "FindOptions.Valid",
+		}
+	}
+	return nil
+}
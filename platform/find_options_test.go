@@ -0,0 +1,31 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestFindOptions_Valid(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    platform.FindOptions
+		wantErr bool
+	}{
+		{name: "zero value", opts: platform.FindOptions{}, wantErr: false},
+		{name: "positive limit and offset", opts: platform.FindOptions{Limit: 10, Offset: 5}, wantErr: false},
+		{name: "negative limit", opts: platform.FindOptions{Limit: -1}, wantErr: true},
+		{name: "negative offset", opts: platform.FindOptions{Offset: -1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Valid()
+			if tt.wantErr && platform.ErrorCode(err) != platform.EInvalid {
+				t.Fatalf("got error %v, want EInvalid", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("got error %v, want nil", err)
+			}
+		})
+	}
+}
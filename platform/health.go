@@ -0,0 +1,15 @@
+package platform
+
+// HealthCheck reports whether a service is initialized and ready to serve
+// requests.
+type HealthCheck struct {
+	Name    string
+	Status  string
+	Message string
+}
+
+// Healthy and Unhealthy are the two Status values a HealthCheck reports.
+const (
+	Healthy   = "pass"
+	Unhealthy = "fail"
+)
@@ -0,0 +1,138 @@
+// Package http exposes platform services over HTTP.
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// DashboardWithViewsFinder resolves a dashboard with its cells' views
+// inlined.
+type DashboardWithViewsFinder interface {
+	FindDashboardWithViews(ctx context.Context, id platform.ID) (*platform.DashboardWithViews, error)
+}
+
+// DashboardHandler serves the platform dashboard HTTP API.
+type DashboardHandler struct {
+	DashboardService platform.DashboardService
+	ViewFinder       DashboardWithViewsFinder
+}
+
+// NewDashboardHandler constructs a DashboardHandler.
+func NewDashboardHandler(ds platform.DashboardService, vf DashboardWithViewsFinder) *DashboardHandler {
+	return &DashboardHandler{DashboardService: ds, ViewFinder: vf}
+}
+
+// ServeHTTP implements http.Handler for /api/v2/dashboards/{id}: GET, with
+// optional ?include=views to embed each cell's resolved view, and PATCH to
+// apply a partial update.
+func (h *DashboardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	idStr := strings.TrimPrefix(r.URL.Path, "/api/v2/dashboards/")
+	id, err := platform.IDFromString(idStr)
+	if err != nil {
+		http.Error(w, "invalid dashboard ID", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.handleGet(w, r, *id)
+	case http.MethodPatch:
+		h.handlePatch(w, r, *id)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *DashboardHandler) handleGet(w http.ResponseWriter, r *http.Request, id platform.ID) {
+	if r.URL.Query().Get("include") == "views" {
+		dv, err := h.ViewFinder.FindDashboardWithViews(r.Context(), id)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, dv)
+		return
+	}
+
+	d, err := h.DashboardService.FindDashboardByID(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, d)
+}
+
+// dashboardPatchRequest is the body of a PATCH /api/v2/dashboards/{id}
+// request. ID is optional, but if the client sets it, it must match the ID
+// in the URL: a mismatch almost always means the client meant to update a
+// different dashboard than the URL names, so this rejects the request
+// instead of silently trusting whichever ID it likes.
+type dashboardPatchRequest struct {
+	ID *string `json:"id,omitempty"`
+	platform.DashboardUpdate
+}
+
+func (h *DashboardHandler) handlePatch(w http.ResponseWriter, r *http.Request, id platform.ID) {
+	var req dashboardPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.ID != nil {
+		bodyID, err := platform.IDFromString(*req.ID)
+		if err != nil || *bodyID != id {
+			writeError(w, &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "id in body does not match id in URL",
+				Op:   "http/handlePatch",
+			})
+			return
+		}
+	}
+
+	d, err := h.DashboardService.UpdateDashboard(r.Context(), id, req.DashboardUpdate)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, d)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch platform.ErrorCode(err) {
+	case platform.ENotFound:
+		status = http.StatusNotFound
+	case platform.EInvalid:
+		status = http.StatusBadRequest
+	case platform.EUnauthorized:
+		status = http.StatusUnauthorized
+	case platform.EConflict:
+		status = http.StatusConflict
+	case platform.EMethodNotAllowed:
+		status = http.StatusNotAcceptable
+	case platform.ETooManyRequests:
+		status = http.StatusTooManyRequests
+	case platform.EUnavailable:
+		status = http.StatusServiceUnavailable
+	case platform.ETimeout:
+		status = http.StatusGatewayTimeout
+	case platform.EClientClosedRequest:
+		// 499 is the nginx-originated de facto standard for "client closed
+		// the request before the server could respond." net/http has no
+		// named constant for it.
+		status = 499
+	}
+	http.Error(w, platform.ErrorMessage(err), status)
+}
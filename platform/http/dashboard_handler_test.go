@@ -0,0 +1,102 @@
+package http_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	phttp "github.com/influxdata/influxdb/platform/http"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDashboardHandler_IncludeViews(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	view := &platform.View{Name: "cpu"}
+	if err := s.CreateView(ctx, view); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+	d := &platform.Dashboard{Cells: []*platform.Cell{{ViewID: view.ID}}}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	h := phttp.NewDashboardHandler(s, s)
+
+	req := httptest.NewRequest("GET", "/api/v2/dashboards/"+d.ID.String()+"?include=views", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDashboardHandler_IncludeViews_MissingView(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{Cells: []*platform.Cell{{ViewID: 12345}}}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	h := phttp.NewDashboardHandler(s, s)
+
+	req := httptest.NewRequest("GET", "/api/v2/dashboards/"+d.ID.String()+"?include=views", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 404 {
+		t.Fatalf("status = %d, want 404; body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDashboardHandler_Patch(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{Name: "original"}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	h := phttp.NewDashboardHandler(s, s)
+
+	body := `{"Name":"renamed"}`
+	req := httptest.NewRequest("PATCH", "/api/v2/dashboards/"+d.ID.String(), strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDashboardHandler_Patch_MismatchedBodyID(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{Name: "original"}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	other := &platform.Dashboard{Name: "other"}
+	if err := s.CreateDashboard(ctx, other); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	h := phttp.NewDashboardHandler(s, s)
+
+	body := `{"id":"` + other.ID.String() + `","Name":"renamed"}`
+	req := httptest.NewRequest("PATCH", "/api/v2/dashboards/"+d.ID.String(), strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 400 {
+		t.Fatalf("status = %d, want 400; body = %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,35 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestWriteError_StatusCodeMapping(t *testing.T) {
+	tests := []struct {
+		code string
+		want int
+	}{
+		{platform.ENotFound, http.StatusNotFound},
+		{platform.EInvalid, http.StatusBadRequest},
+		{platform.EUnauthorized, http.StatusUnauthorized},
+		{platform.EConflict, http.StatusConflict},
+		{platform.EMethodNotAllowed, http.StatusNotAcceptable},
+		{platform.ETooManyRequests, http.StatusTooManyRequests},
+		{platform.EInternal, http.StatusInternalServerError},
+		{platform.EUnavailable, http.StatusServiceUnavailable},
+		{platform.ETimeout, http.StatusGatewayTimeout},
+		{platform.EClientClosedRequest, 499},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		writeError(w, &platform.Error{Code: tt.code, Msg: "boom"})
+		if w.Code != tt.want {
+			t.Errorf("code %q => status %d, want %d", tt.code, w.Code, tt.want)
+		}
+	}
+}
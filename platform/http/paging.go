@@ -0,0 +1,54 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// defaultPageLimit is the page size newPagingLinks assumes when opts.Limit
+// isn't set, purely for computing next/prev/last offsets — it doesn't
+// affect how many results a service actually returns.
+const defaultPageLimit = 20
+
+// newPagingLinks builds the links object for a paged list response at
+// basePath (e.g. "/api/v2/scrapertargets"): self reflects the request as
+// issued, first and last are the two ends of the result set, and next/prev
+// step by one page from the current offset. next is omitted once the
+// current page reaches total; prev is omitted on the first page.
+func newPagingLinks(basePath string, opts platform.FindOptions, total int) map[string]string {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	offset := opts.Offset
+
+	links := map[string]string{
+		"self":  pagingURL(basePath, offset, limit),
+		"first": pagingURL(basePath, 0, limit),
+	}
+
+	if offset > 0 {
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0
+		}
+		links["prev"] = pagingURL(basePath, prevOffset, limit)
+	}
+
+	if offset+limit < total {
+		links["next"] = pagingURL(basePath, offset+limit, limit)
+	}
+
+	lastOffset := 0
+	if total > 0 {
+		lastOffset = ((total - 1) / limit) * limit
+	}
+	links["last"] = pagingURL(basePath, lastOffset, limit)
+
+	return links
+}
+
+func pagingURL(basePath string, offset, limit int) string {
+	return fmt.Sprintf("%s?limit=%d&offset=%d", basePath, limit, offset)
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestNewPagingLinks(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  platform.FindOptions
+		total int
+		want  map[string]string
+	}{
+		{
+			name:  "first page, more remain",
+			opts:  platform.FindOptions{Limit: 10, Offset: 0},
+			total: 25,
+			want: map[string]string{
+				"self":  "/x?limit=10&offset=0",
+				"first": "/x?limit=10&offset=0",
+				"next":  "/x?limit=10&offset=10",
+				"last":  "/x?limit=10&offset=20",
+			},
+		},
+		{
+			name:  "middle page",
+			opts:  platform.FindOptions{Limit: 10, Offset: 10},
+			total: 25,
+			want: map[string]string{
+				"self":  "/x?limit=10&offset=10",
+				"first": "/x?limit=10&offset=0",
+				"prev":  "/x?limit=10&offset=0",
+				"next":  "/x?limit=10&offset=20",
+				"last":  "/x?limit=10&offset=20",
+			},
+		},
+		{
+			name:  "last page, no next",
+			opts:  platform.FindOptions{Limit: 10, Offset: 20},
+			total: 25,
+			want: map[string]string{
+				"self":  "/x?limit=10&offset=20",
+				"first": "/x?limit=10&offset=0",
+				"prev":  "/x?limit=10&offset=10",
+				"last":  "/x?limit=10&offset=20",
+			},
+		},
+		{
+			name:  "no results",
+			opts:  platform.FindOptions{Limit: 10, Offset: 0},
+			total: 0,
+			want: map[string]string{
+				"self":  "/x?limit=10&offset=0",
+				"first": "/x?limit=10&offset=0",
+				"last":  "/x?limit=10&offset=0",
+			},
+		},
+		{
+			name:  "unspecified limit falls back to default",
+			opts:  platform.FindOptions{},
+			total: 5,
+			want: map[string]string{
+				"self":  "/x?limit=20&offset=0",
+				"first": "/x?limit=20&offset=0",
+				"last":  "/x?limit=20&offset=0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newPagingLinks("/x", tt.opts, tt.total)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Fatalf("links[%q] = %q, want %q (all: %v)", k, got[k], v, got)
+				}
+			}
+		})
+	}
+}
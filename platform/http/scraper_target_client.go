@@ -0,0 +1,138 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// ScraperService is an HTTP client for the platform scraper target API. It
+// talks to a ScraperTargetHandler mounted at Addr.
+type ScraperService struct {
+	Addr string
+	// Client is used to make requests. Defaults to http.DefaultClient when
+	// nil.
+	Client *http.Client
+}
+
+// NewScraperService constructs a ScraperService that talks to the handler
+// mounted at addr.
+func NewScraperService(addr string) *ScraperService {
+	return &ScraperService{Addr: addr}
+}
+
+func (s *ScraperService) httpClient() *http.Client {
+	if s.Client != nil {
+		return s.Client
+	}
+	return http.DefaultClient
+}
+
+// ListTargets returns the page of scraper targets matching filter selected
+// by opts, along with the total count of matching targets before paging is
+// applied, by issuing a GET request against the server with filter and opts
+// encoded as query params.
+func (s *ScraperService) ListTargets(ctx context.Context, filter platform.ScraperTargetFilter, opts platform.FindOptions) ([]*platform.ScraperTarget, int, error) {
+	q := url.Values{}
+	if filter.OrganizationID != nil {
+		q.Set("org", filter.OrganizationID.String())
+	}
+	if filter.BucketID != nil {
+		q.Set("bucket", filter.BucketID.String())
+	}
+	if filter.Name != nil {
+		q.Set("name", *filter.Name)
+	}
+	if filter.Type != nil {
+		q.Set("type", string(*filter.Type))
+	}
+	if opts.Limit > 0 {
+		q.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.Offset > 0 {
+		q.Set("offset", strconv.Itoa(opts.Offset))
+	}
+
+	u := s.Addr + "/api/v2/scrapertargets"
+	if encoded := q.Encode(); encoded != "" {
+		u += "?" + encoded
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := s.httpClient().Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, &platform.Error{
+			Code: platform.EInternal,
+			Msg:  "unexpected status from scrapertargets list: " + resp.Status,
+			Op:   "http/ScraperService.ListTargets",
+		}
+	}
+
+	var body scraperTargetsListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, 0, err
+	}
+	return body.ScraperTargets, body.Total, nil
+}
+
+// CountTargets returns the number of scraper targets matching filter by
+// issuing a GET request with ?count=true, so the server can count without
+// materializing and returning the matching targets.
+func (s *ScraperService) CountTargets(ctx context.Context, filter platform.ScraperTargetFilter) (int, error) {
+	q := url.Values{}
+	q.Set("count", "true")
+	if filter.OrganizationID != nil {
+		q.Set("org", filter.OrganizationID.String())
+	}
+	if filter.BucketID != nil {
+		q.Set("bucket", filter.BucketID.String())
+	}
+	if filter.Name != nil {
+		q.Set("name", *filter.Name)
+	}
+	if filter.Type != nil {
+		q.Set("type", string(*filter.Type))
+	}
+
+	u := s.Addr + "/api/v2/scrapertargets?" + q.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := s.httpClient().Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, &platform.Error{
+			Code: platform.EInternal,
+			Msg:  "unexpected status from scrapertargets count: " + resp.Status,
+			Op:   "http/ScraperService.CountTargets",
+		}
+	}
+
+	var body scraperTargetsCountResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, err
+	}
+	return body.Count, nil
+}
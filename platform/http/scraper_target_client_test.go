@@ -0,0 +1,114 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	phttp "github.com/influxdata/influxdb/platform/http"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestScraperService_ListTargets_SendsQueryParams(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	orgID := platform.ID(1)
+	bucketID := platform.ID(2)
+	target := &platform.ScraperTarget{Name: "prod", OrganizationID: orgID, BucketID: bucketID}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+
+	var gotQuery string
+	srv := httptest.NewServer(recordQuery(&gotQuery, h))
+	defer srv.Close()
+
+	client := phttp.NewScraperService(srv.URL)
+	name := "prod"
+	filter := platform.ScraperTargetFilter{OrganizationID: &orgID, BucketID: &bucketID, Name: &name}
+	opts := platform.FindOptions{Limit: 10}
+
+	targets, total, err := client.ListTargets(ctx, filter, opts)
+	if err != nil {
+		t.Fatalf("ListTargets: %v", err)
+	}
+
+	wantQuery := "bucket=" + bucketID.String() + "&limit=10&name=prod&org=" + orgID.String()
+	if gotQuery != wantQuery {
+		t.Fatalf("query = %q, want %q", gotQuery, wantQuery)
+	}
+
+	if total != 1 || len(targets) != 1 || targets[0].Name != "prod" {
+		t.Fatalf("got targets=%+v total=%d", targets, total)
+	}
+}
+
+func TestScraperService_ListTargets_NoFilterOmitsParams(t *testing.T) {
+	s := inmem.NewService()
+	h := phttp.NewScraperTargetHandler(s)
+
+	var gotQuery string
+	srv := httptest.NewServer(recordQuery(&gotQuery, h))
+	defer srv.Close()
+
+	client := phttp.NewScraperService(srv.URL)
+	if _, _, err := client.ListTargets(context.Background(), platform.ScraperTargetFilter{}, platform.FindOptions{}); err != nil {
+		t.Fatalf("ListTargets: %v", err)
+	}
+
+	if gotQuery != "" {
+		t.Fatalf("query = %q, want empty", gotQuery)
+	}
+}
+
+func TestScraperService_CountTargets_MatchesListLength(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	orgID := platform.ID(1)
+	for _, name := range []string{"a", "b", "c"} {
+		target := &platform.ScraperTarget{Name: name, OrganizationID: orgID}
+		if err := s.AddTarget(ctx, target); err != nil {
+			t.Fatalf("AddTarget: %v", err)
+		}
+	}
+	other := &platform.ScraperTarget{Name: "d", OrganizationID: platform.ID(2)}
+	if err := s.AddTarget(ctx, other); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	client := phttp.NewScraperService(srv.URL)
+	filter := platform.ScraperTargetFilter{OrganizationID: &orgID}
+
+	targets, _, err := client.ListTargets(ctx, filter, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("ListTargets: %v", err)
+	}
+	count, err := client.CountTargets(ctx, filter)
+	if err != nil {
+		t.Fatalf("CountTargets: %v", err)
+	}
+
+	if count != len(targets) {
+		t.Fatalf("count = %d, want %d (len of ListTargets)", count, len(targets))
+	}
+	if count != 3 {
+		t.Fatalf("count = %d, want 3", count)
+	}
+}
+
+func recordQuery(dst *string, h *phttp.ScraperTargetHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*dst = r.URL.RawQuery
+		h.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,359 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// validateReachabilityTimeout bounds the optional HTTP probe handleValidate
+// performs against a candidate target's URL, so an unreachable or slow
+// target can't hang the validate request.
+const validateReachabilityTimeout = 5 * time.Second
+
+// ScraperTargetHandler serves the platform scraper target HTTP API.
+type ScraperTargetHandler struct {
+	ScraperTargetStoreService platform.ScraperTargetStoreService
+}
+
+// NewScraperTargetHandler constructs a ScraperTargetHandler.
+func NewScraperTargetHandler(s platform.ScraperTargetStoreService) *ScraperTargetHandler {
+	return &ScraperTargetHandler{ScraperTargetStoreService: s}
+}
+
+type getTargetsByIDsRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// scraperTargetsListResponse is the body of a GET list response: the
+// matching page of targets, the total count before paging, and paging
+// links a client can follow instead of recomputing offsets itself.
+type scraperTargetsListResponse struct {
+	ScraperTargets []*platform.ScraperTarget `json:"scraperTargets"`
+	Total          int                       `json:"total"`
+	Links          map[string]string         `json:"links"`
+}
+
+// scraperTargetsCountResponse is the body of a GET ?count=true response.
+type scraperTargetsCountResponse struct {
+	Count int `json:"count"`
+}
+
+// ServeHTTP implements http.Handler for the platform scraper target API:
+// GET /api/v2/scrapertargets lists targets filtered and paged by query
+// params, POST /api/v2/scrapertargets/get bulk-fetches targets by ID,
+// returning them in request order with a null entry for any ID that
+// doesn't match a target, POST /api/v2/scrapertargets/validate checks a
+// candidate target without saving it, and PATCH /api/v2/scrapertargets/{id}
+// applies a partial update.
+func (h *ScraperTargetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet:
+		h.handleList(w, r)
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/validate"):
+		h.handleValidate(w, r)
+	case r.Method == http.MethodPost:
+		h.handleGetByIDs(w, r)
+	case r.Method == http.MethodPatch:
+		h.handlePatch(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// scraperTargetPatchRequest is the body of a PATCH
+// /api/v2/scrapertargets/{id} request. ID is optional, but if the client
+// sets it, it must match the ID in the URL: a mismatch almost always means
+// the client meant to update a different target than the URL names, so
+// this rejects the request instead of silently trusting whichever ID it
+// likes.
+type scraperTargetPatchRequest struct {
+	ID *string `json:"id,omitempty"`
+	platform.ScraperTargetUpdate
+}
+
+// handlePatch serves PATCH /api/v2/scrapertargets/{id}.
+func (h *ScraperTargetHandler) handlePatch(w http.ResponseWriter, r *http.Request) {
+	pretty, err := acceptsJSON(r.Header.Get("Accept"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	idStr := strings.TrimPrefix(r.URL.Path, "/api/v2/scrapertargets/")
+	id, err := platform.IDFromString(idStr)
+	if err != nil {
+		http.Error(w, "invalid target ID", http.StatusBadRequest)
+		return
+	}
+
+	var req scraperTargetPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.ID != nil {
+		bodyID, err := platform.IDFromString(*req.ID)
+		if err != nil || *bodyID != *id {
+			writeError(w, &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "id in body does not match id in URL",
+				Op:   "http/handlePatch",
+			})
+			return
+		}
+	}
+
+	target, err := h.ScraperTargetStoreService.PatchTarget(r.Context(), *id, req.ScraperTargetUpdate)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if pretty {
+		writeJSONIndent(w, target)
+		return
+	}
+	writeJSON(w, target)
+}
+
+// scraperTargetValidationResponse is the body of a POST
+// /api/v2/scrapertargets/validate response. It never causes a non-2xx
+// status by itself: Valid and Reachable false are reported results, not
+// request failures.
+type scraperTargetValidationResponse struct {
+	// Valid reports whether ScraperTarget.Valid() accepted the target.
+	Valid bool `json:"valid"`
+	// ValidationError is Valid's error message, set only when Valid is
+	// false.
+	ValidationError string `json:"validationError,omitempty"`
+	// Reachable reports whether the GET probe against URL succeeded.
+	// Skipped (left false) when Valid is false, since an invalid target's
+	// URL isn't worth probing.
+	Reachable bool `json:"reachable"`
+	// ReachError is the probe's error message, set only when Valid is true
+	// but Reachable is false.
+	ReachError  string `json:"reachError,omitempty"`
+	StatusCode  int    `json:"statusCode,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// handleValidate serves POST /api/v2/scrapertargets/validate: it runs
+// ScraperTarget.Valid() against the request body and, if that passes,
+// performs a short-timeout GET against the target's URL to check
+// reachability and content type. Nothing is persisted either way.
+func (h *ScraperTargetHandler) handleValidate(w http.ResponseWriter, r *http.Request) {
+	pretty, err := acceptsJSON(r.Header.Get("Accept"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var target platform.ScraperTarget
+	if err := json.NewDecoder(r.Body).Decode(&target); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	var resp scraperTargetValidationResponse
+	if err := target.Valid(); err != nil {
+		resp.ValidationError = platform.ErrorMessage(err)
+	} else {
+		resp.Valid = true
+	}
+
+	if resp.Valid {
+		client := &http.Client{Timeout: validateReachabilityTimeout}
+		req, err := http.NewRequest(http.MethodGet, target.URL, nil)
+		if err != nil {
+			resp.ReachError = err.Error()
+		} else {
+			hresp, err := client.Do(req)
+			if err != nil {
+				resp.ReachError = err.Error()
+			} else {
+				defer hresp.Body.Close()
+				resp.Reachable = true
+				resp.StatusCode = hresp.StatusCode
+				resp.ContentType = hresp.Header.Get("Content-Type")
+			}
+		}
+	}
+
+	if pretty {
+		writeJSONIndent(w, resp)
+		return
+	}
+	writeJSON(w, resp)
+}
+
+// handleList serves GET /api/v2/scrapertargets, filtering by the org,
+// bucket, name, and type query params and paging by limit/offset.
+func (h *ScraperTargetHandler) handleList(w http.ResponseWriter, r *http.Request) {
+	pretty, err := acceptsJSON(r.Header.Get("Accept"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	q := r.URL.Query()
+	var filter platform.ScraperTargetFilter
+	if v := q.Get("org"); v != "" {
+		id, err := platform.IDFromString(v)
+		if err != nil {
+			http.Error(w, "invalid org ID", http.StatusBadRequest)
+			return
+		}
+		filter.OrganizationID = id
+	}
+	if v := q.Get("bucket"); v != "" {
+		id, err := platform.IDFromString(v)
+		if err != nil {
+			http.Error(w, "invalid bucket ID", http.StatusBadRequest)
+			return
+		}
+		filter.BucketID = id
+	}
+	if v := q.Get("name"); v != "" {
+		filter.Name = &v
+	}
+	if v := q.Get("type"); v != "" {
+		st := platform.ScraperType(v)
+		filter.Type = &st
+	}
+
+	var opts platform.FindOptions
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		opts.Limit = n
+	}
+	if v := q.Get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "invalid offset", http.StatusBadRequest)
+			return
+		}
+		opts.Offset = n
+	}
+
+	if q.Get("count") == "true" {
+		n, err := h.ScraperTargetStoreService.CountTargets(r.Context(), filter)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		resp := scraperTargetsCountResponse{Count: n}
+		if pretty {
+			writeJSONIndent(w, resp)
+			return
+		}
+		writeJSON(w, resp)
+		return
+	}
+
+	targets, total, err := h.ScraperTargetStoreService.FindTargets(r.Context(), filter, opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp := scraperTargetsListResponse{
+		ScraperTargets: targets,
+		Total:          total,
+		Links:          newPagingLinks("/api/v2/scrapertargets", opts, total),
+	}
+	if pretty {
+		writeJSONIndent(w, resp)
+		return
+	}
+	writeJSON(w, resp)
+}
+
+// handleGetByIDs serves POST /api/v2/scrapertargets/get.
+func (h *ScraperTargetHandler) handleGetByIDs(w http.ResponseWriter, r *http.Request) {
+	pretty, err := acceptsJSON(r.Header.Get("Accept"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req getTargetsByIDsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ids := make([]platform.ID, len(req.IDs))
+	for i, idStr := range req.IDs {
+		id, err := platform.IDFromString(idStr)
+		if err != nil {
+			http.Error(w, "invalid target ID: "+idStr, http.StatusBadRequest)
+			return
+		}
+		ids[i] = *id
+	}
+
+	targets, err := h.ScraperTargetStoreService.GetTargetsByIDs(r.Context(), ids)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if pretty {
+		writeJSONIndent(w, targets)
+		return
+	}
+	writeJSON(w, targets)
+}
+
+// acceptsJSON inspects an Accept header and reports whether the response
+// should be pretty-printed. It accepts an empty header, "*/*",
+// "application/json", and "application/json; indent=2" (pretty); any other
+// value is rejected with EMethodNotAllowed so the handler can respond 406
+// instead of silently ignoring what the client asked for.
+func acceptsJSON(accept string) (pretty bool, err error) {
+	accept = strings.TrimSpace(accept)
+	if accept == "" || accept == "*/*" || accept == "application/json" {
+		return false, nil
+	}
+
+	mediaType, params := parseAccept(accept)
+	if mediaType != "application/json" {
+		return false, &platform.Error{
+			Code: platform.EMethodNotAllowed,
+			Msg:  "unsupported Accept header: " + accept,
+			Op:   "http/acceptsJSON",
+		}
+	}
+	return params["indent"] == "2", nil
+}
+
+// parseAccept splits an Accept header of the form
+// "type/subtype; param=value; ..." into its media type and parameters.
+func parseAccept(accept string) (mediaType string, params map[string]string) {
+	parts := strings.Split(accept, ";")
+	mediaType = strings.TrimSpace(parts[0])
+	params = make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		kv := strings.SplitN(strings.TrimSpace(p), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		params[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return mediaType, params
+}
+
+func writeJSONIndent(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
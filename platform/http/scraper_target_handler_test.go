@@ -0,0 +1,245 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	phttp "github.com/influxdata/influxdb/platform/http"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestScraperTargetHandler_AllPresent(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.ScraperTarget{Name: "a"}
+	b := &platform.ScraperTarget{Name: "b"}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if err := s.AddTarget(ctx, b); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+
+	body := `{"ids":["` + a.ID.String() + `","` + b.ID.String() + `"]}`
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/get", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+
+	var got []*platform.ScraperTarget
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "a" || got[1].Name != "b" {
+		t.Fatalf("got %+v", got)
+	}
+}
+
+func TestScraperTargetHandler_SomeMissing(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.ScraperTarget{Name: "a"}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+
+	missing := platform.ID(1).String()
+	body := `{"ids":["` + a.ID.String() + `","` + missing + `"]}`
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/get", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+
+	var got []*platform.ScraperTarget
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "a" || got[1] != nil {
+		t.Fatalf("got %+v", got)
+	}
+}
+
+func TestScraperTargetHandler_DescriptionRoundTrip(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	withDesc := &platform.ScraperTarget{Name: "with-desc", Description: "scrapes the prod fleet"}
+	withoutDesc := &platform.ScraperTarget{Name: "without-desc"}
+	if err := s.AddTarget(ctx, withDesc); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if err := s.AddTarget(ctx, withoutDesc); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+
+	body := `{"ids":["` + withDesc.ID.String() + `","` + withoutDesc.ID.String() + `"]}`
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/get", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), `"description":"scrapes the prod fleet"`) {
+		t.Fatalf("body missing description: %s", w.Body.String())
+	}
+	if strings.Contains(w.Body.String(), `without-desc","description"`) {
+		t.Fatalf("omitempty description leaked for target without one: %s", w.Body.String())
+	}
+
+	var got []*platform.ScraperTarget
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got[0].Description != "scrapes the prod fleet" || got[1].Description != "" {
+		t.Fatalf("got %+v", got)
+	}
+}
+
+func TestScraperTargetHandler_PrettyPrintedResponse(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.ScraperTarget{Name: "a"}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+
+	body := `{"ids":["` + a.ID.String() + `"]}`
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/get", strings.NewReader(body))
+	req.Header.Set("Accept", "application/json; indent=2")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "\n  ") {
+		t.Fatalf("expected indented JSON, got: %s", w.Body.String())
+	}
+}
+
+func TestScraperTargetHandler_CompactResponseByDefault(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.ScraperTarget{Name: "a"}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+
+	body := `{"ids":["` + a.ID.String() + `"]}`
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/get", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+	if strings.Contains(w.Body.String(), "\n  ") {
+		t.Fatalf("expected compact JSON, got: %s", w.Body.String())
+	}
+}
+
+func TestScraperTargetHandler_UnsupportedAcceptRejected(t *testing.T) {
+	s := inmem.NewService()
+	h := phttp.NewScraperTargetHandler(s)
+
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/get", strings.NewReader(`{"ids":[]}`))
+	req.Header.Set("Accept", "application/xml")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 406 {
+		t.Fatalf("status = %d, want 406; body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestScraperTargetHandler_InvalidID(t *testing.T) {
+	s := inmem.NewService()
+	h := phttp.NewScraperTargetHandler(s)
+
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/get", strings.NewReader(`{"ids":["not-an-id"]}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 400 {
+		t.Fatalf("status = %d, want 400; body = %s", w.Code, w.Body.String())
+	}
+}
+
+func TestScraperTargetHandler_Patch(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.ScraperTarget{Name: "a"}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+
+	body := `{"Name":"renamed"}`
+	req := httptest.NewRequest("PATCH", "/api/v2/scrapertargets/"+a.ID.String(), strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+
+	var got platform.ScraperTarget
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.Name != "renamed" {
+		t.Fatalf("got name %q, want %q", got.Name, "renamed")
+	}
+}
+
+func TestScraperTargetHandler_Patch_MismatchedBodyID(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.ScraperTarget{Name: "a"}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	other := &platform.ScraperTarget{Name: "b"}
+	if err := s.AddTarget(ctx, other); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	h := phttp.NewScraperTargetHandler(s)
+
+	body := `{"id":"` + other.ID.String() + `","Name":"renamed"}`
+	req := httptest.NewRequest("PATCH", "/api/v2/scrapertargets/"+a.ID.String(), strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 400 {
+		t.Fatalf("status = %d, want 400; body = %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,96 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	phttp "github.com/influxdata/influxdb/platform/http"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestScraperTargetHandler_Validate_Reachable(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("up 1\n"))
+	}))
+	defer upstream.Close()
+
+	h := phttp.NewScraperTargetHandler(inmem.NewService())
+
+	body := `{"name":"prod","url":"` + upstream.URL + `","OrganizationID":"0000000000000001"}`
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/validate", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Valid       bool
+		Reachable   bool
+		StatusCode  int
+		ContentType string
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !resp.Valid || !resp.Reachable || resp.StatusCode != 200 || resp.ContentType != "text/plain" {
+		t.Fatalf("got %+v", resp)
+	}
+}
+
+func TestScraperTargetHandler_Validate_ReachableButNotFound(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+	}))
+	defer upstream.Close()
+
+	h := phttp.NewScraperTargetHandler(inmem.NewService())
+
+	body := `{"name":"prod","url":"` + upstream.URL + `","OrganizationID":"0000000000000001"}`
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/validate", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Valid      bool
+		Reachable  bool
+		StatusCode int
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !resp.Valid || !resp.Reachable || resp.StatusCode != 404 {
+		t.Fatalf("got %+v, want valid+reachable with statusCode 404", resp)
+	}
+}
+
+func TestScraperTargetHandler_Validate_InvalidTarget(t *testing.T) {
+	h := phttp.NewScraperTargetHandler(inmem.NewService())
+
+	body := `{"name":""}`
+	req := httptest.NewRequest("POST", "/api/v2/scrapertargets/validate", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Valid           bool
+		ValidationError string
+		Reachable       bool
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if resp.Valid || resp.ValidationError == "" || resp.Reachable {
+		t.Fatalf("got %+v, want invalid with no reachability probe attempted", resp)
+	}
+}
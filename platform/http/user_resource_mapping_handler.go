@@ -0,0 +1,120 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// UserResourceMappingHandler serves the platform user resource mapping HTTP
+// API: GET /api/v2/{resourceType}s/{resourceID}/members lists a resource's
+// member mappings, and GET /api/v2/{resourceType}s/{resourceID}/owners
+// lists its owner mappings.
+type UserResourceMappingHandler struct {
+	UserResourceMappingService platform.UserResourceMappingService
+}
+
+// NewUserResourceMappingHandler constructs a UserResourceMappingHandler.
+func NewUserResourceMappingHandler(s platform.UserResourceMappingService) *UserResourceMappingHandler {
+	return &UserResourceMappingHandler{UserResourceMappingService: s}
+}
+
+// userResourceMappingsResponse is the body of a members/owners GET
+// response.
+type userResourceMappingsResponse struct {
+	Users []*platform.UserResourceMapping `json:"users"`
+	Links map[string]string               `json:"links"`
+}
+
+// ServeHTTP implements http.Handler. The path must end in either "/members"
+// or "/owners"; the resource type and ID come from the two path segments
+// before that.
+func (h *UserResourceMappingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	resourceType, resourceID, userType, err := parseUserResourceMappingPath(r.URL.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pretty, err := acceptsJSON(r.Header.Get("Accept"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	filter := platform.UserResourceMappingFilter{
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+		UserType:     &userType,
+	}
+	mappings, _, err := h.UserResourceMappingService.FindUserResourceMappings(r.Context(), filter)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp := userResourceMappingsResponse{
+		Users: mappings,
+		Links: map[string]string{"self": r.URL.String()},
+	}
+	if pretty {
+		writeJSONIndent(w, resp)
+		return
+	}
+	writeJSON(w, resp)
+}
+
+// parseUserResourceMappingPath extracts the resource type, resource ID, and
+// requested UserType from a path of the form
+// "/api/v2/{resourceType}s/{resourceID}/members" or ".../owners".
+func parseUserResourceMappingPath(path string) (platform.ResourceType, platform.ID, platform.UserType, error) {
+	trimmed := strings.TrimPrefix(path, "/api/v2/")
+	segments := strings.Split(trimmed, "/")
+	if len(segments) != 3 {
+		return "", 0, "", &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "path must be of the form /api/v2/{resourceType}s/{resourceID}/members or /owners",
+			Op:   "http/parseUserResourceMappingPath",
+		}
+	}
+
+	var userType platform.UserType
+	switch segments[2] {
+	case "members":
+		userType = platform.Member
+	case "owners":
+		userType = platform.Owner
+	default:
+		return "", 0, "", &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "unknown mapping listing: " + segments[2],
+			Op:   "http/parseUserResourceMappingPath",
+		}
+	}
+
+	resourceType := platform.ResourceType(strings.TrimSuffix(segments[0], "s"))
+	if !resourceType.Valid() {
+		return "", 0, "", &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "unknown resource type: " + segments[0],
+			Op:   "http/parseUserResourceMappingPath",
+		}
+	}
+
+	id, err := platform.IDFromString(segments[1])
+	if err != nil {
+		return "", 0, "", &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "invalid resource ID: " + segments[1],
+			Op:   "http/parseUserResourceMappingPath",
+		}
+	}
+
+	return resourceType, *id, userType, nil
+}
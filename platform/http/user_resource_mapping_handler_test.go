@@ -0,0 +1,103 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	phttp "github.com/influxdata/influxdb/platform/http"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestUserResourceMappingHandler_Members(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	bucket := platform.ID(1)
+	owner := platform.ID(2)
+	member := platform.ID(3)
+	if err := s.CreateUserResourceMapping(ctx, &platform.UserResourceMapping{
+		UserID: owner, UserType: platform.Owner, ResourceType: platform.BucketResourceType, ResourceID: bucket,
+	}); err != nil {
+		t.Fatalf("CreateUserResourceMapping: %v", err)
+	}
+	if err := s.CreateUserResourceMapping(ctx, &platform.UserResourceMapping{
+		UserID: member, UserType: platform.Member, ResourceType: platform.BucketResourceType, ResourceID: bucket,
+	}); err != nil {
+		t.Fatalf("CreateUserResourceMapping: %v", err)
+	}
+
+	h := phttp.NewUserResourceMappingHandler(s)
+
+	req := httptest.NewRequest("GET", "/api/v2/buckets/"+bucket.String()+"/members", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+
+	var resp struct {
+		Users []*platform.UserResourceMapping `json:"users"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(resp.Users) != 1 || resp.Users[0].UserID != member {
+		t.Fatalf("got %+v, want only the member mapping", resp.Users)
+	}
+}
+
+func TestUserResourceMappingHandler_Owners(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	bucket := platform.ID(1)
+	owner := platform.ID(2)
+	member := platform.ID(3)
+	if err := s.CreateUserResourceMapping(ctx, &platform.UserResourceMapping{
+		UserID: owner, UserType: platform.Owner, ResourceType: platform.BucketResourceType, ResourceID: bucket,
+	}); err != nil {
+		t.Fatalf("CreateUserResourceMapping: %v", err)
+	}
+	if err := s.CreateUserResourceMapping(ctx, &platform.UserResourceMapping{
+		UserID: member, UserType: platform.Member, ResourceType: platform.BucketResourceType, ResourceID: bucket,
+	}); err != nil {
+		t.Fatalf("CreateUserResourceMapping: %v", err)
+	}
+
+	h := phttp.NewUserResourceMappingHandler(s)
+
+	req := httptest.NewRequest("GET", "/api/v2/buckets/"+bucket.String()+"/owners", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, want 200; body = %s", w.Code, w.Body.String())
+	}
+
+	var resp struct {
+		Users []*platform.UserResourceMapping `json:"users"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(resp.Users) != 1 || resp.Users[0].UserID != owner {
+		t.Fatalf("got %+v, want only the owner mapping", resp.Users)
+	}
+}
+
+func TestUserResourceMappingHandler_InvalidPath(t *testing.T) {
+	s := inmem.NewService()
+	h := phttp.NewUserResourceMappingHandler(s)
+
+	req := httptest.NewRequest("GET", "/api/v2/buckets/"+platform.ID(1).String()+"/friends", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != 400 {
+		t.Fatalf("status = %d, want 400", w.Code)
+	}
+}
@@ -0,0 +1,68 @@
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ID is a unique identifier for a platform resource. It is encoded as a
+// fixed-width hex string wherever it crosses an API boundary (JSON, URLs).
+type ID uint64
+
+// IDLength is the number of hex characters in the string encoding of an ID.
+const IDLength = 16
+
+// ErrInvalidID is returned when a string cannot be parsed into an ID.
+var ErrInvalidID = errors.New("invalid ID")
+
+// IDGenerator generates unique IDs for platform resources.
+type IDGenerator interface {
+	ID() ID
+}
+
+// Valid reports whether the ID is a non-zero identifier.
+func (i ID) Valid() bool {
+	return i != 0
+}
+
+// String returns the fixed-width hex encoding of the ID.
+func (i ID) String() string {
+	return fmt.Sprintf("%016x", uint64(i))
+}
+
+// IDFromString parses s, which must be an IDLength-character hex string,
+// into an ID.
+func IDFromString(s string) (*ID, error) {
+	if len(s) != IDLength {
+		return nil, ErrInvalidID
+	}
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	id := ID(v)
+	return &id, nil
+}
+
+// MarshalJSON implements json.Marshaler. Because it has a value receiver,
+// it applies uniformly to ID, *ID, and ID fields nested anywhere inside a
+// larger struct (a Permission's Resource.ID, a LabelMapping's ResourceID,
+// and so on) — there is no separate code path that could serialize an ID
+// as a bare number instead of its fixed-width hex string.
+func (i ID) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + i.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *ID) UnmarshalJSON(b []byte) error {
+	if len(b) < 2 {
+		return ErrInvalidID
+	}
+	id, err := IDFromString(string(b[1 : len(b)-1]))
+	if err != nil {
+		return err
+	}
+	*i = *id
+	return nil
+}
@@ -0,0 +1,54 @@
+package platform_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// TestID_MarshalJSON_NestedConsistency guards against an ID nested inside
+// another struct ever serializing differently than a bare ID, e.g. because
+// a field is typed *ID, or the struct grows a custom MarshalJSON of its
+// own that bypasses ID's.
+func TestID_MarshalJSON_NestedConsistency(t *testing.T) {
+	id := platform.ID(1)
+	want := `"` + id.String() + `"`
+
+	perm := platform.NewPermissionAtID(id, platform.ReadAction, platform.BucketResourceType)
+	permJSON, err := json.Marshal(perm)
+	if err != nil {
+		t.Fatalf("Marshal(Permission): %v", err)
+	}
+	var permOut struct {
+		Resource struct {
+			ID json.RawMessage
+		}
+	}
+	if err := json.Unmarshal(permJSON, &permOut); err != nil {
+		t.Fatalf("Unmarshal(Permission): %v", err)
+	}
+	if string(permOut.Resource.ID) != want {
+		t.Fatalf("Permission.Resource.ID marshaled as %s, want %s", permOut.Resource.ID, want)
+	}
+
+	mapping := platform.LabelMapping{ResourceID: id, LabelID: platform.ID(2)}
+	mappingJSON, err := json.Marshal(mapping)
+	if err != nil {
+		t.Fatalf("Marshal(LabelMapping): %v", err)
+	}
+	var mappingOut struct {
+		ResourceID json.RawMessage
+		LabelID    json.RawMessage
+	}
+	if err := json.Unmarshal(mappingJSON, &mappingOut); err != nil {
+		t.Fatalf("Unmarshal(LabelMapping): %v", err)
+	}
+	if string(mappingOut.ResourceID) != want {
+		t.Fatalf("LabelMapping.ResourceID marshaled as %s, want %s", mappingOut.ResourceID, want)
+	}
+	wantLabelID := `"` + platform.ID(2).String() + `"`
+	if string(mappingOut.LabelID) != wantLabelID {
+		t.Fatalf("LabelMapping.LabelID marshaled as %s, want %s", mappingOut.LabelID, wantLabelID)
+	}
+}
@@ -0,0 +1,100 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.AuthorizationService = (*Service)(nil)
+
+// FindAuthorizationByID returns a single authorization by ID.
+func (s *Service) FindAuthorizationByID(ctx context.Context, id platform.ID) (*platform.Authorization, error) {
+	v, ok := s.authorizationKV.Load(id)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "authorization not found",
+			Op:   s.op("FindAuthorizationByID"),
+		}
+	}
+	return v.(*platform.Authorization), nil
+}
+
+// FindAuthorizations returns all authorizations matching filter. Every
+// non-nil filter field must match (AND); an empty result is returned as an
+// empty slice, not an error.
+func (s *Service) FindAuthorizations(ctx context.Context, filter platform.AuthorizationFilter) ([]*platform.Authorization, error) {
+	var auths []*platform.Authorization
+	s.authorizationKV.Range(func(k, v interface{}) bool {
+		a := v.(*platform.Authorization)
+		if filter.ID != nil && a.ID != *filter.ID {
+			return true
+		}
+		if filter.UserID != nil && a.UserID != *filter.UserID {
+			return true
+		}
+		if filter.OrgID != nil && a.OrganizationID != *filter.OrgID {
+			return true
+		}
+		if filter.Token != nil && a.Token != *filter.Token {
+			return true
+		}
+		auths = append(auths, a)
+		return true
+	})
+	return auths, nil
+}
+
+// FindAuthorizationByToken returns the authorization whose Token equals
+// token, served from an index instead of scanning every stored
+// authorization.
+func (s *Service) FindAuthorizationByToken(ctx context.Context, token string) (*platform.Authorization, error) {
+	v, ok := s.authorizationByToken.Load(token)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "authorization not found",
+			Op:   s.op("FindAuthorizationByToken"),
+		}
+	}
+	return s.FindAuthorizationByID(ctx, v.(platform.ID))
+}
+
+// CreateAuthorization creates a new authorization, assigning it an ID if it
+// doesn't already have one and stamping its CreatedAt with the current time.
+// New authorizations default to Active if no status is set.
+func (s *Service) CreateAuthorization(ctx context.Context, a *platform.Authorization) error {
+	if !a.ID.Valid() {
+		a.ID = s.idGen.ID()
+	}
+	if a.Status == "" {
+		a.Status = platform.Active
+	}
+	a.CreatedAt = s.timeGen.Now()
+	s.authorizationKV.Store(a.ID, a)
+	s.authorizationByToken.Store(a.Token, a.ID)
+	return nil
+}
+
+// UpdateAuthorizationStatus sets an authorization's status.
+func (s *Service) UpdateAuthorizationStatus(ctx context.Context, id platform.ID, status platform.AuthorizationStatus) (*platform.Authorization, error) {
+	a, err := s.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	a.Status = status
+	s.authorizationKV.Store(a.ID, a)
+	return a, nil
+}
+
+// DeleteAuthorization removes an authorization by ID.
+func (s *Service) DeleteAuthorization(ctx context.Context, id platform.ID) error {
+	a, err := s.FindAuthorizationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	s.authorizationKV.Delete(id)
+	s.authorizationByToken.Delete(a.Token)
+	return nil
+}
@@ -0,0 +1,67 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindAuthorizations_ByUserAndOrg(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	user := platform.ID(1)
+	orgA := platform.ID(10)
+	orgB := platform.ID(20)
+	otherUser := platform.ID(2)
+
+	inOrgA := &platform.Authorization{UserID: user, OrganizationID: orgA, Token: "a"}
+	inOrgB := &platform.Authorization{UserID: user, OrganizationID: orgB, Token: "b"}
+	otherUserInOrgA := &platform.Authorization{UserID: otherUser, OrganizationID: orgA, Token: "c"}
+	for _, a := range []*platform.Authorization{inOrgA, inOrgB, otherUserInOrgA} {
+		if err := s.CreateAuthorization(ctx, a); err != nil {
+			t.Fatalf("CreateAuthorization: %v", err)
+		}
+	}
+
+	got, err := s.FindAuthorizations(ctx, platform.AuthorizationFilter{UserID: &user, OrgID: &orgA})
+	if err != nil {
+		t.Fatalf("FindAuthorizations: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != inOrgA.ID {
+		t.Fatalf("got %+v, want exactly [inOrgA]", got)
+	}
+
+	byUserOnly, err := s.FindAuthorizations(ctx, platform.AuthorizationFilter{UserID: &user})
+	if err != nil {
+		t.Fatalf("FindAuthorizations: %v", err)
+	}
+	if len(byUserOnly) != 2 {
+		t.Fatalf("got %d authorizations, want 2 (one per org)", len(byUserOnly))
+	}
+}
+
+func TestFindAuthorizations_ByToken(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.Authorization{Token: "secret-token"}
+	if err := s.CreateAuthorization(ctx, a); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+	other := &platform.Authorization{Token: "other-token"}
+	if err := s.CreateAuthorization(ctx, other); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+
+	token := "secret-token"
+	got, err := s.FindAuthorizations(ctx, platform.AuthorizationFilter{Token: &token})
+	if err != nil {
+		t.Fatalf("FindAuthorizations: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != a.ID {
+		t.Fatalf("got %+v, want exactly [a]", got)
+	}
+}
@@ -0,0 +1,54 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindAuthorizationByToken(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.Authorization{Token: "secret-token"}
+	if err := s.CreateAuthorization(ctx, a); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+
+	got, err := s.FindAuthorizationByToken(ctx, "secret-token")
+	if err != nil {
+		t.Fatalf("FindAuthorizationByToken: %v", err)
+	}
+	if got.ID != a.ID {
+		t.Fatalf("got ID %v, want %v", got.ID, a.ID)
+	}
+}
+
+func TestFindAuthorizationByToken_NotFound(t *testing.T) {
+	s := inmem.NewService()
+
+	_, err := s.FindAuthorizationByToken(context.Background(), "no-such-token")
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got %v, want ENotFound", err)
+	}
+}
+
+func TestFindAuthorizationByToken_PostDeleteNotFound(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	a := &platform.Authorization{Token: "secret-token"}
+	if err := s.CreateAuthorization(ctx, a); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+	if err := s.DeleteAuthorization(ctx, a.ID); err != nil {
+		t.Fatalf("DeleteAuthorization: %v", err)
+	}
+
+	_, err := s.FindAuthorizationByToken(ctx, "secret-token")
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got %v, want ENotFound", err)
+	}
+}
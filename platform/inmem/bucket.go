@@ -0,0 +1,116 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.BucketService = (*Service)(nil)
+
+// FindBucketByID returns a single bucket by ID.
+func (s *Service) FindBucketByID(ctx context.Context, id platform.ID) (*platform.Bucket, error) {
+	v, ok := s.bucketKV.Load(id)
+	if !ok {
+		return nil, notFound(s.op("FindBucketByID"), platform.BucketResourceType, id)
+	}
+	return v.(*platform.Bucket), nil
+}
+
+// FindBuckets returns the buckets matching filter, along with the total
+// number that matched before opts.Limit/Offset were applied. Filtering by
+// an OrganizationID with no buckets returns an empty slice, not an error.
+// filter.Name, combined with the OrganizationID filter via AND, matches
+// exactly unless filter.CaseInsensitive is set.
+func (s *Service) FindBuckets(ctx context.Context, filter platform.BucketFilter, opts platform.FindOptions) ([]*platform.Bucket, int, error) {
+	if err := opts.Valid(); err != nil {
+		return nil, 0, err
+	}
+
+	var buckets []*platform.Bucket
+	s.bucketKV.Range(func(k, v interface{}) bool {
+		b := v.(*platform.Bucket)
+		if filter.OrganizationID != nil && b.OrganizationID != *filter.OrganizationID {
+			return true
+		}
+		if filter.Name != nil {
+			if filter.CaseInsensitive {
+				if !strings.EqualFold(b.Name, *filter.Name) {
+					return true
+				}
+			} else if b.Name != *filter.Name {
+				return true
+			}
+		}
+		buckets = append(buckets, b)
+		return true
+	})
+
+	less := idLess(opts.Descending)
+	sort.Slice(buckets, func(i, j int) bool { return less(buckets[i].ID, buckets[j].ID) })
+
+	total := len(buckets)
+
+	if opts.Offset > 0 {
+		if opts.Offset >= len(buckets) {
+			return []*platform.Bucket{}, total, nil
+		}
+		buckets = buckets[opts.Offset:]
+	}
+
+	if opts.Limit > 0 && opts.Limit < len(buckets) {
+		buckets = buckets[:opts.Limit]
+	}
+
+	return buckets, total, nil
+}
+
+// CreateBucket creates a new bucket, assigning it an ID if it doesn't
+// already have one.
+func (s *Service) CreateBucket(ctx context.Context, b *platform.Bucket) error {
+	if err := b.Valid(); err != nil {
+		return err
+	}
+
+	if !b.ID.Valid() {
+		b.ID = s.idGen.ID()
+	}
+	s.bucketKV.Store(b.ID, b)
+	return nil
+}
+
+// UpdateBucket applies upd to the bucket with the given ID.
+func (s *Service) UpdateBucket(ctx context.Context, id platform.ID, upd platform.BucketUpdate) (*platform.Bucket, error) {
+	b, err := s.FindBucketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *b
+	if upd.Name != nil {
+		updated.Name = *upd.Name
+	}
+	if upd.RetentionPeriod != nil {
+		updated.RetentionPeriod = *upd.RetentionPeriod
+	}
+
+	if err := updated.Valid(); err != nil {
+		return nil, err
+	}
+
+	s.bucketKV.Store(updated.ID, &updated)
+	s.invalidateName(platform.BucketResourceType, updated.ID)
+	return &updated, nil
+}
+
+// DeleteBucket removes a bucket by ID.
+func (s *Service) DeleteBucket(ctx context.Context, id platform.ID) error {
+	if _, err := s.FindBucketByID(ctx, id); err != nil {
+		return err
+	}
+	s.bucketKV.Delete(id)
+	s.invalidateName(platform.BucketResourceType, id)
+	return nil
+}
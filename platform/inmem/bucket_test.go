@@ -0,0 +1,163 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindBuckets_FiltersByOrganization(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	orgA := platform.ID(1)
+	orgB := platform.ID(2)
+
+	for _, name := range []string{"a1", "a2", "a3"} {
+		if err := s.CreateBucket(ctx, &platform.Bucket{Name: name, OrganizationID: orgA}); err != nil {
+			t.Fatalf("CreateBucket: %v", err)
+		}
+	}
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "b1", OrganizationID: orgB}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+
+	got, total, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &orgA}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindBuckets: %v", err)
+	}
+	if total != 3 || len(got) != 3 {
+		t.Fatalf("got %d buckets (total=%d), want 3 (total=3)", len(got), total)
+	}
+}
+
+func TestFindBuckets_OrgWithNoBucketsReturnsEmptyNotError(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	emptyOrg := platform.ID(42)
+	got, total, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &emptyOrg}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindBuckets: %v", err)
+	}
+	if total != 0 || len(got) != 0 {
+		t.Fatalf("got %+v (total=%d), want empty", got, total)
+	}
+}
+
+func TestFindBuckets_HonorsLimitAndOffset(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	orgA := platform.ID(1)
+	for _, name := range []string{"a1", "a2", "a3", "a4", "a5"} {
+		if err := s.CreateBucket(ctx, &platform.Bucket{Name: name, OrganizationID: orgA}); err != nil {
+			t.Fatalf("CreateBucket: %v", err)
+		}
+	}
+
+	got, total, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &orgA}, platform.FindOptions{Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatalf("FindBuckets: %v", err)
+	}
+	if total != 5 {
+		t.Fatalf("got total=%d, want 5", total)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d buckets, want 2", len(got))
+	}
+}
+
+func TestFindBuckets_DescendingReversesOrder(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	orgA := platform.ID(1)
+	for _, name := range []string{"a1", "a2", "a3"} {
+		if err := s.CreateBucket(ctx, &platform.Bucket{Name: name, OrganizationID: orgA}); err != nil {
+			t.Fatalf("CreateBucket: %v", err)
+		}
+	}
+
+	ascending, _, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &orgA}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindBuckets: %v", err)
+	}
+	descending, _, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &orgA}, platform.FindOptions{Descending: true})
+	if err != nil {
+		t.Fatalf("FindBuckets: %v", err)
+	}
+
+	if len(ascending) != len(descending) {
+		t.Fatalf("got %d ascending, %d descending, want equal lengths", len(ascending), len(descending))
+	}
+	for i := range ascending {
+		if ascending[i].ID != descending[len(descending)-1-i].ID {
+			t.Fatalf("descending order is not the reverse of ascending order: %+v vs %+v", ascending, descending)
+		}
+	}
+}
+
+func TestFindBuckets_NameFilter(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	orgA := platform.ID(1)
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "Prod", OrganizationID: orgA}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "Staging", OrganizationID: orgA}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+
+	name := "Prod"
+	t.Run("exact match", func(t *testing.T) {
+		got, total, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &orgA, Name: &name}, platform.FindOptions{})
+		if err != nil {
+			t.Fatalf("FindBuckets: %v", err)
+		}
+		if total != 1 || len(got) != 1 || got[0].Name != "Prod" {
+			t.Fatalf("got %+v (total=%d), want exactly [Prod]", got, total)
+		}
+	})
+
+	t.Run("case-insensitive match", func(t *testing.T) {
+		lower := "prod"
+		got, total, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &orgA, Name: &lower, CaseInsensitive: true}, platform.FindOptions{})
+		if err != nil {
+			t.Fatalf("FindBuckets: %v", err)
+		}
+		if total != 1 || len(got) != 1 || got[0].Name != "Prod" {
+			t.Fatalf("got %+v (total=%d), want exactly [Prod]", got, total)
+		}
+	})
+
+	t.Run("case-sensitive no match", func(t *testing.T) {
+		lower := "prod"
+		got, total, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &orgA, Name: &lower}, platform.FindOptions{})
+		if err != nil {
+			t.Fatalf("FindBuckets: %v", err)
+		}
+		if total != 0 || len(got) != 0 {
+			t.Fatalf("got %+v (total=%d), want empty", got, total)
+		}
+	})
+}
+
+func TestFindBuckets_NegativeOptionsRejectedNotPanicking(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "a1", OrganizationID: platform.ID(1)}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+
+	if _, _, err := s.FindBuckets(ctx, platform.BucketFilter{}, platform.FindOptions{Offset: -1}); platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("got error %v, want EInvalid", err)
+	}
+	if _, _, err := s.FindBuckets(ctx, platform.BucketFilter{}, platform.FindOptions{Limit: -1}); platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("got error %v, want EInvalid", err)
+	}
+}
@@ -0,0 +1,68 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestCreateBucket_NegativeRetentionRejected(t *testing.T) {
+	s := inmem.NewService()
+	err := s.CreateBucket(context.Background(), &platform.Bucket{
+		Name:            "b1",
+		OrganizationID:  platform.ID(1),
+		RetentionPeriod: -time.Hour,
+	})
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("err = %v, want EInvalid", err)
+	}
+}
+
+func TestCreateBucket_EmptyNameRejected(t *testing.T) {
+	s := inmem.NewService()
+	err := s.CreateBucket(context.Background(), &platform.Bucket{
+		OrganizationID: platform.ID(1),
+	})
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("err = %v, want EInvalid", err)
+	}
+}
+
+func TestUpdateBucket_NegativeRetentionRejected(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	b := &platform.Bucket{Name: "b1", OrganizationID: platform.ID(1)}
+	if err := s.CreateBucket(ctx, b); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+
+	bad := -time.Hour
+	_, err := s.UpdateBucket(ctx, b.ID, platform.BucketUpdate{RetentionPeriod: &bad})
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("err = %v, want EInvalid", err)
+	}
+}
+
+func TestUpdateBucket_AppliesValidChanges(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	b := &platform.Bucket{Name: "b1", OrganizationID: platform.ID(1)}
+	if err := s.CreateBucket(ctx, b); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+
+	newName := "renamed"
+	period := 2 * time.Hour
+	updated, err := s.UpdateBucket(ctx, b.ID, platform.BucketUpdate{Name: &newName, RetentionPeriod: &period})
+	if err != nil {
+		t.Fatalf("UpdateBucket: %v", err)
+	}
+	if updated.Name != "renamed" || updated.RetentionPeriod != 2*time.Hour {
+		t.Fatalf("got %+v", updated)
+	}
+}
@@ -0,0 +1,85 @@
+package inmem
+
+import (
+	"github.com/influxdata/influxdb/platform"
+)
+
+// Clone returns a new Service holding a deep copy of every resource s
+// currently stores, sharing s's IDGenerator. Mutating the clone (or a value
+// returned from it) never affects s, and vice versa, so tests can seed one
+// shared fixture Service and Clone it per case instead of rebuilding it
+// from scratch.
+//
+// It deliberately starts the clone with fresh, disabled name/view/count
+// caches rather than copying s's: a cache entry is only a hint back to the
+// same underlying data, and s and the clone no longer share that data once
+// Clone returns.
+func (s *Service) Clone() *Service {
+	clone := &Service{
+		idGen:               s.idGen,
+		timeGen:             s.timeGen,
+		dashboardCountCache: newCountCache(0),
+		targetCountCache:    newCountCache(0),
+	}
+
+	s.organizationKV.Range(func(k, v interface{}) bool {
+		o := *v.(*platform.Organization)
+		clone.organizationKV.Store(k, &o)
+		return true
+	})
+	s.bucketKV.Range(func(k, v interface{}) bool {
+		b := *v.(*platform.Bucket)
+		clone.bucketKV.Store(k, &b)
+		return true
+	})
+	s.userKV.Range(func(k, v interface{}) bool {
+		u := *v.(*platform.User)
+		clone.userKV.Store(k, &u)
+		return true
+	})
+	s.dashboardKV.Range(func(k, v interface{}) bool {
+		clone.dashboardKV.Store(k, v.(*platform.Dashboard).Clone())
+		return true
+	})
+	s.viewKV.Range(func(k, v interface{}) bool {
+		view := *v.(*platform.View)
+		clone.viewKV.Store(k, &view)
+		return true
+	})
+	s.telegrafKV.Range(func(k, v interface{}) bool {
+		tc := *v.(*platform.TelegrafConfig)
+		clone.telegrafKV.Store(k, &tc)
+		return true
+	})
+	s.scraperKV.Range(func(k, v interface{}) bool {
+		t := *v.(*platform.ScraperTarget)
+		clone.scraperKV.Store(k, &t)
+		return true
+	})
+	s.labelKV.Range(func(k, v interface{}) bool {
+		l := *v.(*platform.Label)
+		clone.labelKV.Store(k, &l)
+		return true
+	})
+	s.labelMappingKV.Range(func(k, v interface{}) bool {
+		m := *v.(*platform.LabelMapping)
+		clone.labelMappingKV.Store(k, &m)
+		return true
+	})
+	s.authorizationKV.Range(func(k, v interface{}) bool {
+		a := *v.(*platform.Authorization)
+		clone.authorizationKV.Store(k, &a)
+		return true
+	})
+	s.authorizationByToken.Range(func(k, v interface{}) bool {
+		clone.authorizationByToken.Store(k, v)
+		return true
+	})
+	s.urmKV.Range(func(k, v interface{}) bool {
+		m := *v.(*platform.UserResourceMapping)
+		clone.urmKV.Store(k, &m)
+		return true
+	})
+
+	return clone
+}
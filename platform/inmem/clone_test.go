@@ -0,0 +1,60 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestClone_IsolatesMutations(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := &platform.Organization{Name: "acme"}
+	if err := s.CreateOrganization(ctx, org); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	original := &platform.Dashboard{Name: "original", OrganizationID: org.ID}
+	if err := s.CreateDashboard(ctx, original); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	clone := s.Clone()
+
+	// Creating a dashboard in the clone must not appear in the original.
+	if err := clone.CreateDashboard(ctx, &platform.Dashboard{Name: "clone-only", OrganizationID: org.ID}); err != nil {
+		t.Fatalf("CreateDashboard on clone: %v", err)
+	}
+
+	originalDashboards, _, err := s.FindDashboards(ctx, platform.DashboardFilter{}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	if len(originalDashboards) != 1 {
+		t.Fatalf("got %d dashboards in original after cloning, want 1 (clone leaked back)", len(originalDashboards))
+	}
+
+	cloneDashboards, _, err := clone.FindDashboards(ctx, platform.DashboardFilter{}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards on clone: %v", err)
+	}
+	if len(cloneDashboards) != 2 {
+		t.Fatalf("got %d dashboards in clone, want 2", len(cloneDashboards))
+	}
+
+	// Renaming the shared dashboard's clone must not affect the original's copy.
+	newName := "renamed-in-clone"
+	if _, err := clone.UpdateDashboard(ctx, original.ID, platform.DashboardUpdate{Name: &newName}); err != nil {
+		t.Fatalf("UpdateDashboard on clone: %v", err)
+	}
+
+	gotOriginal, err := s.FindDashboardByID(ctx, original.ID)
+	if err != nil {
+		t.Fatalf("FindDashboardByID: %v", err)
+	}
+	if gotOriginal.Name != "original" {
+		t.Fatalf("got name %q on original after mutating clone, want %q", gotOriginal.Name, "original")
+	}
+}
@@ -0,0 +1,56 @@
+package inmem
+
+import (
+	"sync"
+	"time"
+)
+
+// countCache holds a single cached count for a short TTL, for resources
+// (like a dashboard or scraper target total) that are listed far more often
+// than they change. A zero or negative ttl disables the cache: get always
+// misses and set is a no-op.
+type countCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	value     int
+	expiresAt time.Time
+	valid     bool
+}
+
+func newCountCache(ttl time.Duration) *countCache {
+	return &countCache{ttl: ttl}
+}
+
+func (c *countCache) get() (int, bool) {
+	if c.ttl <= 0 {
+		return 0, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.valid || time.Now().After(c.expiresAt) {
+		return 0, false
+	}
+	return c.value, true
+}
+
+func (c *countCache) set(v int) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.value = v
+	c.expiresAt = time.Now().Add(c.ttl)
+	c.valid = true
+}
+
+func (c *countCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.valid = false
+}
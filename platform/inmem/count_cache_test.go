@@ -0,0 +1,74 @@
+package inmem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestCountCache_GetSetInvalidate(t *testing.T) {
+	c := newCountCache(time.Minute)
+
+	if _, ok := c.get(); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	c.set(5)
+	got, ok := c.get()
+	if !ok || got != 5 {
+		t.Fatalf("got %d, %v, want 5, true", got, ok)
+	}
+
+	c.invalidate()
+	if _, ok := c.get(); ok {
+		t.Fatal("expected miss after invalidate")
+	}
+}
+
+func TestCountCache_ExpiresAfterTTL(t *testing.T) {
+	c := newCountCache(time.Millisecond)
+	c.set(3)
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.get(); ok {
+		t.Fatal("expected miss after TTL expiry")
+	}
+}
+
+func TestCountCache_DisabledWhenTTLNonPositive(t *testing.T) {
+	c := newCountCache(0)
+	c.set(3)
+	if _, ok := c.get(); ok {
+		t.Fatal("expected disabled cache to never hit")
+	}
+}
+
+func TestService_CountDashboards_ServedFromCacheWithinTTL(t *testing.T) {
+	s := NewService(WithCountCache(time.Minute))
+	ctx := context.Background()
+
+	n, err := s.CountDashboards(ctx)
+	if err != nil {
+		t.Fatalf("CountDashboards: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("got %d, want 0", n)
+	}
+
+	// Store a dashboard directly, bypassing CreateDashboard's cache
+	// invalidation, to prove the next read is served from cache rather than
+	// recomputed.
+	d := &platform.Dashboard{ID: 1, Name: "direct"}
+	s.dashboardKV.Store(d.ID, d)
+
+	n, err = s.CountDashboards(ctx)
+	if err != nil {
+		t.Fatalf("CountDashboards: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("got %d, want stale cached 0 (cache not honored within TTL)", n)
+	}
+}
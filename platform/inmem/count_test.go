@@ -0,0 +1,98 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestCountDashboards_InvalidatedByCreateAndDelete(t *testing.T) {
+	s := inmem.NewService(inmem.WithCountCache(time.Minute))
+	ctx := context.Background()
+
+	n, err := s.CountDashboards(ctx)
+	if err != nil {
+		t.Fatalf("CountDashboards: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("got %d, want 0", n)
+	}
+
+	d := &platform.Dashboard{Name: "d1"}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	n, err = s.CountDashboards(ctx)
+	if err != nil {
+		t.Fatalf("CountDashboards: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("got %d after create, want 1 — cache not invalidated", n)
+	}
+
+	if err := s.DeleteDashboard(ctx, d.ID); err != nil {
+		t.Fatalf("DeleteDashboard: %v", err)
+	}
+
+	n, err = s.CountDashboards(ctx)
+	if err != nil {
+		t.Fatalf("CountDashboards: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("got %d after delete, want 0 — cache not invalidated", n)
+	}
+}
+
+func TestCountTargets_InvalidatedByAddAndRemove(t *testing.T) {
+	s := inmem.NewService(inmem.WithCountCache(time.Minute))
+	ctx := context.Background()
+
+	n, err := s.CountTargets(ctx, platform.ScraperTargetFilter{})
+	if err != nil {
+		t.Fatalf("CountTargets: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("got %d, want 0", n)
+	}
+
+	target := &platform.ScraperTarget{Name: "t1"}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	n, err = s.CountTargets(ctx, platform.ScraperTargetFilter{})
+	if err != nil {
+		t.Fatalf("CountTargets: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("got %d after add, want 1 — cache not invalidated", n)
+	}
+
+	if err := s.RemoveTarget(ctx, target.ID); err != nil {
+		t.Fatalf("RemoveTarget: %v", err)
+	}
+
+	n, err = s.CountTargets(ctx, platform.ScraperTargetFilter{})
+	if err != nil {
+		t.Fatalf("CountTargets: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("got %d after remove, want 0 — cache not invalidated", n)
+	}
+}
+
+func TestCountDashboards_DisabledByDefault(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.CreateDashboard(ctx, &platform.Dashboard{Name: "d1"}); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	if n, err := s.CountDashboards(ctx); err != nil || n != 1 {
+		t.Fatalf("got %d, %v, want 1, nil", n, err)
+	}
+}
@@ -0,0 +1,382 @@
+package inmem
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.DashboardService = (*Service)(nil)
+
+// FindDashboardByID returns a single dashboard by ID. The returned
+// Dashboard is a copy; mutating it has no effect on the stored value.
+func (s *Service) FindDashboardByID(ctx context.Context, id platform.ID) (*platform.Dashboard, error) {
+	d, err := s.findDashboardByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return d.Clone(), nil
+}
+
+// findDashboardByID returns the stored dashboard pointer, for internal use
+// by operations that need to read-modify-write it under dashboardMu.
+func (s *Service) findDashboardByID(ctx context.Context, id platform.ID) (*platform.Dashboard, error) {
+	v, ok := s.dashboardKV.Load(id)
+	if !ok {
+		return nil, notFound(s.op("FindDashboardByID"), platform.DashboardResourceType, id)
+	}
+	return v.(*platform.Dashboard), nil
+}
+
+// FindDashboards returns a list of dashboards matching filter, along with
+// the total number of dashboards that matched before opts.Limit/Offset were
+// applied.
+//
+// opts.Offset beyond the end of the matched set returns an empty slice with
+// the true total. opts.Limit of zero means "no limit".
+func (s *Service) FindDashboards(ctx context.Context, filter platform.DashboardFilter, opts platform.FindOptions) ([]*platform.Dashboard, int, error) {
+	if err := opts.Valid(); err != nil {
+		return nil, 0, err
+	}
+
+	var dashboards []*platform.Dashboard
+	if idFilterFastPathEligible(filter) {
+		dashboards = s.findDashboardsByIDs(filter)
+	} else {
+		dashboards = s.findDashboardsByScan(filter)
+	}
+
+	sortDashboards(opts.SortBy, opts.Descending, dashboards)
+
+	total := len(dashboards)
+
+	if opts.Offset > 0 {
+		if opts.Offset >= len(dashboards) {
+			return []*platform.Dashboard{}, total, nil
+		}
+		dashboards = dashboards[opts.Offset:]
+	}
+
+	if opts.Limit > 0 && opts.Limit < len(dashboards) {
+		dashboards = dashboards[:opts.Limit]
+	}
+
+	return dashboards, total, nil
+}
+
+// FindDashboardsByLabel returns the page of dashboards mapped to labelID
+// selected by opts, along with the total count of matching dashboards
+// before paging is applied. Dashboards are ordered by ID, honoring
+// opts.Descending; a labelID with no mappings, or whose mapped dashboards
+// have since been deleted, returns an empty slice rather than an error.
+func (s *Service) FindDashboardsByLabel(ctx context.Context, labelID platform.ID, opts platform.FindOptions) ([]*platform.Dashboard, int, error) {
+	if err := opts.Valid(); err != nil {
+		return nil, 0, err
+	}
+
+	var dashboards []*platform.Dashboard
+	s.labelMappingKV.Range(func(k, v interface{}) bool {
+		key := k.(labelMappingKey)
+		if key.labelID != labelID {
+			return true
+		}
+		if dv, ok := s.dashboardKV.Load(key.resourceID); ok {
+			if d := dv.(*platform.Dashboard); d.Meta.DeletedAt == nil {
+				dashboards = append(dashboards, d.Clone())
+			}
+		}
+		return true
+	})
+
+	less := idLess(opts.Descending)
+	sort.Slice(dashboards, func(i, j int) bool { return less(dashboards[i].ID, dashboards[j].ID) })
+
+	total := len(dashboards)
+
+	if opts.Offset > 0 {
+		if opts.Offset >= len(dashboards) {
+			return []*platform.Dashboard{}, total, nil
+		}
+		dashboards = dashboards[opts.Offset:]
+	}
+
+	if opts.Limit > 0 && opts.Limit < len(dashboards) {
+		dashboards = dashboards[:opts.Limit]
+	}
+
+	return dashboards, total, nil
+}
+
+// StreamDashboards writes every dashboard matching filter to w as
+// newline-delimited JSON, in the same order FindDashboards would return
+// them. The matching dashboards are first collected, as pointers to the
+// stored values, and sorted; each is then encoded and written to w one at a
+// time, so no encoded body for the whole result is built in memory.
+func (s *Service) StreamDashboards(ctx context.Context, filter platform.DashboardFilter, w io.Writer) error {
+	var dashboards []*platform.Dashboard
+	if idFilterFastPathEligible(filter) {
+		dashboards = s.findDashboardsByIDs(filter)
+	} else {
+		dashboards = s.findDashboardsByScan(filter)
+	}
+
+	sortDashboards("", false, dashboards)
+
+	enc := json.NewEncoder(w)
+	for _, d := range dashboards {
+		if err := enc.Encode(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CountDashboards returns the number of non-deleted dashboards. If the
+// Service was constructed with WithCountCache, this is served from cache
+// for up to the configured TTL rather than recomputed on every call.
+func (s *Service) CountDashboards(ctx context.Context) (int, error) {
+	if n, ok := s.dashboardCountCache.get(); ok {
+		return n, nil
+	}
+
+	_, total, err := s.FindDashboards(ctx, platform.DashboardFilter{}, platform.FindOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	s.dashboardCountCache.set(total)
+	return total, nil
+}
+
+// idFilterFastPathEligible reports whether filter can be satisfied by
+// direct-loading each of filter.IDs instead of scanning every stored
+// dashboard. It requires every other filter field be at its zero value,
+// since those need to inspect dashboards this lookup never visits.
+func idFilterFastPathEligible(filter platform.DashboardFilter) bool {
+	return len(filter.IDs) > 0 && filter.OrganizationID == nil && filter.Name == ""
+}
+
+// findDashboardsByIDs is the O(len(filter.IDs)) fast path for a filter that
+// only constrains by ID: a direct sync.Map.Load per ID instead of a full
+// scan. Duplicate IDs in filter.IDs are only returned once.
+func (s *Service) findDashboardsByIDs(filter platform.DashboardFilter) []*platform.Dashboard {
+	var dashboards []*platform.Dashboard
+	seen := make(map[platform.ID]bool, len(filter.IDs))
+	for _, id := range filter.IDs {
+		if id == nil || seen[*id] {
+			continue
+		}
+		seen[*id] = true
+
+		v, ok := s.dashboardKV.Load(*id)
+		if !ok {
+			continue
+		}
+		if d := v.(*platform.Dashboard); filter.IncludeDeleted || d.Meta.DeletedAt == nil {
+			dashboards = append(dashboards, d)
+		}
+	}
+	return dashboards
+}
+
+// findDashboardsByScan is the O(N) fallback that visits every stored
+// dashboard, for filters that idFilterFastPathEligible rejects.
+func (s *Service) findDashboardsByScan(filter platform.DashboardFilter) []*platform.Dashboard {
+	compiled := CompileDashboardFilter(filter)
+	var dashboards []*platform.Dashboard
+	s.dashboardKV.Range(func(k, v interface{}) bool {
+		d := v.(*platform.Dashboard)
+		if compiled.Matches(d) {
+			dashboards = append(dashboards, d)
+		}
+		return true
+	})
+	return dashboards
+}
+
+// sortDashboards orders dashboards by sortBy ("ID", "CreatedAt", "UpdatedAt",
+// or the default of Name), reversed if descending is set. Ties always fall
+// back to ID ascending, regardless of descending, so paging stays
+// deterministic.
+func sortDashboards(sortBy string, descending bool, dashboards []*platform.Dashboard) {
+	// primaryLess reports whether i sorts before j on the requested key
+	// alone, ignoring direction and tie-breaking; its result is flipped for
+	// descending, and when the key doesn't distinguish i, j the ID decides.
+	var primaryLess func(i, j int) bool
+	switch sortBy {
+	case "ID":
+		primaryLess = func(i, j int) bool { return dashboards[i].ID < dashboards[j].ID }
+	case "CreatedAt":
+		primaryLess = func(i, j int) bool {
+			return dashboards[i].Meta.CreatedAt.Before(dashboards[j].Meta.CreatedAt)
+		}
+	case "UpdatedAt":
+		primaryLess = func(i, j int) bool {
+			return dashboards[i].Meta.UpdatedAt.Before(dashboards[j].Meta.UpdatedAt)
+		}
+	default:
+		primaryLess = func(i, j int) bool { return dashboards[i].Name < dashboards[j].Name }
+	}
+
+	sort.Slice(dashboards, func(i, j int) bool {
+		switch {
+		case primaryLess(i, j):
+			return !descending
+		case primaryLess(j, i):
+			return descending
+		default:
+			// Tie on the primary key: always break by ID ascending,
+			// regardless of direction, so paging stays deterministic.
+			return dashboards[i].ID < dashboards[j].ID
+		}
+	})
+}
+
+// CreateDashboard creates a new dashboard, assigning it an ID if it doesn't
+// already have one.
+func (s *Service) CreateDashboard(ctx context.Context, d *platform.Dashboard) error {
+	if err := platform.ValidateName(s.op("CreateDashboard"), d.Name); err != nil {
+		return err
+	}
+
+	if !d.ID.Valid() {
+		d.ID = s.idGen.ID()
+	}
+
+	now := s.timeGen.Now()
+	d.Meta.CreatedAt = now
+	d.Meta.UpdatedAt = now
+
+	s.dashboardKV.Store(d.ID, d)
+	s.dashboardCountCache.invalidate()
+	return nil
+}
+
+// UpdateDashboard applies upd to the dashboard with the given ID.
+//
+// It holds the service-wide mu, in addition to dashboardMu, so it can't
+// interleave with a cross-resource operation (e.g. PurgeDashboard,
+// CloneDashboard, or DeleteOrganization's cascade) on the same dashboard.
+// Without mu too, this could re-Store a dashboard that PurgeDashboard or
+// DeleteOrganization just removed, resurrecting it.
+func (s *Service) UpdateDashboard(ctx context.Context, id platform.ID, upd platform.DashboardUpdate) (*platform.Dashboard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dashboardMu.Lock()
+	defer s.dashboardMu.Unlock()
+
+	d, err := s.FindDashboardByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if upd.Name != nil {
+		d.Name = *upd.Name
+	}
+	if upd.Description != nil {
+		d.Description = *upd.Description
+	}
+	d.Meta.UpdatedAt = s.timeGen.Now()
+
+	s.dashboardKV.Store(d.ID, d)
+	s.invalidateName(platform.DashboardResourceType, d.ID)
+	return d, nil
+}
+
+// DeleteDashboard soft-deletes a dashboard by setting its Meta.DeletedAt,
+// hiding it from FindDashboards (unless DashboardFilter.IncludeDeleted is
+// set) without removing it. Use RestoreDashboard to undo, or PurgeDashboard
+// to remove it permanently.
+//
+// It holds the service-wide mu, in addition to dashboardMu, so it can't
+// interleave with a cross-resource operation (e.g. PurgeDashboard,
+// CloneDashboard, or DeleteOrganization's cascade) on the same dashboard.
+func (s *Service) DeleteDashboard(ctx context.Context, id platform.ID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dashboardMu.Lock()
+	defer s.dashboardMu.Unlock()
+
+	d, err := s.findDashboardByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	now := s.timeGen.Now()
+	d.Meta.DeletedAt = &now
+	s.dashboardKV.Store(d.ID, d)
+	s.invalidateName(platform.DashboardResourceType, id)
+	s.dashboardCountCache.invalidate()
+	return nil
+}
+
+// RestoreDashboard clears a dashboard's soft-delete, making it visible again
+// in default FindDashboards results. It's a no-op if the dashboard isn't
+// currently deleted.
+//
+// It holds the service-wide mu, in addition to dashboardMu, so it can't
+// interleave with a cross-resource operation (e.g. PurgeDashboard,
+// CloneDashboard, or DeleteOrganization's cascade) on the same dashboard.
+func (s *Service) RestoreDashboard(ctx context.Context, id platform.ID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dashboardMu.Lock()
+	defer s.dashboardMu.Unlock()
+
+	d, err := s.findDashboardByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	d.Meta.DeletedAt = nil
+	s.dashboardKV.Store(d.ID, d)
+	s.dashboardCountCache.invalidate()
+	return nil
+}
+
+// PurgeDashboard permanently removes a dashboard, whether or not it's been
+// soft-deleted, along with any label mappings pointing at it. Label mapping
+// removal is idempotent, so a concurrent deletion of the same mapping
+// (e.g. from a separate DeleteLabel call) doesn't turn into an error here.
+// Unlike DeleteDashboard, this cannot be undone.
+//
+// It touches both the dashboard and label mapping stores, so it holds the
+// service-wide mu for its whole run to keep it from interleaving with
+// another cross-resource operation (e.g. CloneDashboard) on the same
+// dashboard.
+func (s *Service) PurgeDashboard(ctx context.Context, id platform.ID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.purgeDashboardLocked(ctx, id)
+}
+
+// purgeDashboardLocked is PurgeDashboard's body, factored out so callers
+// that already hold s.mu (e.g. DeleteOrganization's cascade) can reuse it
+// without deadlocking on a second Lock.
+func (s *Service) purgeDashboardLocked(ctx context.Context, id platform.ID) error {
+	if _, err := s.findDashboardByID(ctx, id); err != nil {
+		return err
+	}
+	s.dashboardKV.Delete(id)
+	s.invalidateName(platform.DashboardResourceType, id)
+	s.dashboardCountCache.invalidate()
+
+	mappings, _, err := s.FindLabelMappings(ctx, id, platform.FindOptions{})
+	if err != nil {
+		return err
+	}
+	for _, m := range mappings {
+		if err := s.DeleteLabelMapping(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
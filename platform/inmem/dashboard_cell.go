@@ -0,0 +1,64 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// MoveDashboardCell repositions a cell within its dashboard's cell list to
+// newIndex, preserving the relative order of the other cells.
+//
+// It holds the service-wide mu, in addition to dashboardMu, so it can't
+// interleave with a cross-resource operation (e.g. CloneDashboard) reading
+// the same dashboard's cells mid-move.
+func (s *Service) MoveDashboardCell(ctx context.Context, dashboardID, cellID platform.ID, newIndex int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dashboardMu.Lock()
+	defer s.dashboardMu.Unlock()
+
+	d, err := s.FindDashboardByID(ctx, dashboardID)
+	if err != nil {
+		return err
+	}
+
+	from := -1
+	for i, c := range d.Cells {
+		if c.ID == cellID {
+			from = i
+			break
+		}
+	}
+	if from == -1 {
+		return &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "cell not found",
+			Op:   s.op("MoveDashboardCell"),
+		}
+	}
+
+	if newIndex < 0 || newIndex >= len(d.Cells) {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "newIndex out of range",
+			Op:   s.op("MoveDashboardCell"),
+		}
+	}
+
+	cell := d.Cells[from]
+
+	remaining := make([]*platform.Cell, 0, len(d.Cells)-1)
+	remaining = append(remaining, d.Cells[:from]...)
+	remaining = append(remaining, d.Cells[from+1:]...)
+
+	cells := make([]*platform.Cell, 0, len(d.Cells))
+	cells = append(cells, remaining[:newIndex]...)
+	cells = append(cells, cell)
+	cells = append(cells, remaining[newIndex:]...)
+	d.Cells = cells
+
+	s.dashboardKV.Store(d.ID, d)
+	return nil
+}
@@ -0,0 +1,158 @@
+package inmem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// AddDashboardCellOptions controls optional validation performed by
+// AddDashboardCell and ReplaceDashboardCells.
+type AddDashboardCellOptions struct {
+	// PreventOverlap rejects a cell whose rectangle overlaps an existing
+	// cell's. Left off by default, since some users intentionally overlap
+	// cells.
+	PreventOverlap bool
+
+	// CloneViewFrom, if set, creates a new view copying the properties of
+	// the view it identifies and points the cell at that new view instead
+	// of cell.ViewID. It's an ENotFound error if the source view doesn't
+	// exist. Only used by AddDashboardCell.
+	CloneViewFrom *platform.ID
+}
+
+// AddDashboardCell appends a cell to a dashboard. The cell must reference a
+// valid view via ViewID, unless opts.CloneViewFrom is set, in which case a
+// new view is created from the source view and the cell is pointed at it.
+// A zero ViewID with no CloneViewFrom is rejected with EInvalid, as is a
+// ViewID that doesn't resolve in the view store.
+func (s *Service) AddDashboardCell(ctx context.Context, dashboardID platform.ID, cell *platform.Cell, opts AddDashboardCellOptions) error {
+	if opts.CloneViewFrom != nil {
+		srcView, err := s.FindViewByID(ctx, *opts.CloneViewFrom)
+		if err != nil {
+			return err
+		}
+
+		newView := &platform.View{Name: srcView.Name}
+		if err := s.CreateView(ctx, newView); err != nil {
+			return err
+		}
+		cell.ViewID = newView.ID
+	}
+
+	if !cell.ViewID.Valid() {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "cell must have a valid ViewID",
+			Op:   s.op("AddDashboardCell"),
+		}
+	}
+	if _, err := s.FindViewByID(ctx, cell.ViewID); err != nil {
+		return &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "cell references view " + cell.ViewID.String() + " which does not exist",
+			Op:   s.op("AddDashboardCell"),
+		}
+	}
+
+	s.dashboardMu.Lock()
+	defer s.dashboardMu.Unlock()
+
+	d, err := s.FindDashboardByID(ctx, dashboardID)
+	if err != nil {
+		return err
+	}
+
+	if opts.PreventOverlap {
+		if conflicts := overlappingCells(cell, d.Cells); len(conflicts) > 0 {
+			return overlapError(s.op("AddDashboardCell"), conflicts)
+		}
+	}
+
+	if !cell.ID.Valid() {
+		cell.ID = s.idGen.ID()
+	}
+	d.Cells = append(d.Cells, cell)
+	s.dashboardKV.Store(d.ID, d)
+	return nil
+}
+
+// ReplaceDashboardCells replaces all cells on a dashboard. Every cell must
+// reference a valid view via ViewID that resolves in the view store; a
+// zero or dangling ViewID is rejected with EInvalid and the dashboard is
+// left unmodified.
+func (s *Service) ReplaceDashboardCells(ctx context.Context, dashboardID platform.ID, cells []*platform.Cell, opts AddDashboardCellOptions) error {
+	for _, c := range cells {
+		if !c.ViewID.Valid() {
+			return &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "cell must have a valid ViewID",
+				Op:   s.op("ReplaceDashboardCells"),
+			}
+		}
+		if _, err := s.FindViewByID(ctx, c.ViewID); err != nil {
+			return &platform.Error{
+				Code: platform.EInvalid,
+				Msg:  "cell " + c.ID.String() + " references view " + c.ViewID.String() + " which does not exist",
+				Op:   s.op("ReplaceDashboardCells"),
+			}
+		}
+	}
+
+	if opts.PreventOverlap {
+		for i, c := range cells {
+			if conflicts := overlappingCells(c, append(append([]*platform.Cell{}, cells[:i]...), cells[i+1:]...)); len(conflicts) > 0 {
+				return overlapError(s.op("ReplaceDashboardCells"), conflicts)
+			}
+		}
+	}
+
+	s.dashboardMu.Lock()
+	defer s.dashboardMu.Unlock()
+
+	d, err := s.FindDashboardByID(ctx, dashboardID)
+	if err != nil {
+		return err
+	}
+
+	d.Cells = cells
+	s.dashboardKV.Store(d.ID, d)
+	return nil
+}
+
+// overlappingCells returns the cells in against whose rectangle overlaps
+// cell's. Edge-adjacent rectangles (sharing only a border) don't count as
+// overlapping.
+func overlappingCells(cell *platform.Cell, against []*platform.Cell) []*platform.Cell {
+	var conflicts []*platform.Cell
+	for _, other := range against {
+		if rectanglesOverlap(cell.CellProperty, other.CellProperty) {
+			conflicts = append(conflicts, other)
+		}
+	}
+	return conflicts
+}
+
+func rectanglesOverlap(a, b platform.CellProperty) bool {
+	if a.X+a.W <= b.X || b.X+b.W <= a.X {
+		return false
+	}
+	if a.Y+a.H <= b.Y || b.Y+b.H <= a.Y {
+		return false
+	}
+	return true
+}
+
+func overlapError(op string, conflicts []*platform.Cell) error {
+	ids := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		ids[i] = c.ID.String()
+	}
+	return &platform.Error{
+		Code: platform.EInvalid,
+		Msg:  fmt.Sprintf("cell overlaps existing cells: %s", strings.Join(ids, ", ")),
+		Op:   op,
+	}
+}
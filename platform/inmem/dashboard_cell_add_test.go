@@ -0,0 +1,143 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestAddDashboardCell_RequiresViewID(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	if err := s.AddDashboardCell(ctx, d.ID, &platform.Cell{}, inmem.AddDashboardCellOptions{}); platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("AddDashboardCell with zero ViewID: got %v, want EInvalid", err)
+	}
+
+	view := &platform.View{Name: "cpu usage"}
+	if err := s.CreateView(ctx, view); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	if err := s.AddDashboardCell(ctx, d.ID, &platform.Cell{ViewID: view.ID}, inmem.AddDashboardCellOptions{}); err != nil {
+		t.Fatalf("AddDashboardCell with valid ViewID: %v", err)
+	}
+}
+
+func TestAddDashboardCell_DanglingViewID(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	dangling := platform.ID(12345)
+	err := s.AddDashboardCell(ctx, d.ID, &platform.Cell{ViewID: dangling}, inmem.AddDashboardCellOptions{})
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("AddDashboardCell with dangling ViewID: got %v, want EInvalid", err)
+	}
+}
+
+func TestAddDashboardCell_CloneViewFrom(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	srcView := &platform.View{Name: "cpu usage"}
+	if err := s.CreateView(ctx, srcView); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	cell := &platform.Cell{}
+	if err := s.AddDashboardCell(ctx, d.ID, cell, inmem.AddDashboardCellOptions{CloneViewFrom: &srcView.ID}); err != nil {
+		t.Fatalf("AddDashboardCell: %v", err)
+	}
+
+	if cell.ViewID == srcView.ID {
+		t.Fatalf("cell should reference a new view, not the source view")
+	}
+	newView, err := s.FindViewByID(ctx, cell.ViewID)
+	if err != nil {
+		t.Fatalf("FindViewByID: %v", err)
+	}
+	if newView.Name != srcView.Name {
+		t.Fatalf("got view name %q, want %q inherited from source", newView.Name, srcView.Name)
+	}
+}
+
+func TestAddDashboardCell_CloneViewFromMissingSource(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	missing := platform.ID(1)
+	err := s.AddDashboardCell(ctx, d.ID, &platform.Cell{}, inmem.AddDashboardCellOptions{CloneViewFrom: &missing})
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got %v, want ENotFound", err)
+	}
+}
+
+func TestReplaceDashboardCells_RequiresViewID(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	view1 := &platform.View{Name: "view one"}
+	if err := s.CreateView(ctx, view1); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+	view2 := &platform.View{Name: "view two"}
+	if err := s.CreateView(ctx, view2); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	err := s.ReplaceDashboardCells(ctx, d.ID, []*platform.Cell{{ViewID: view1.ID}, {}}, inmem.AddDashboardCellOptions{})
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("ReplaceDashboardCells with a zero ViewID: got %v, want EInvalid", err)
+	}
+
+	if err := s.ReplaceDashboardCells(ctx, d.ID, []*platform.Cell{{ViewID: view1.ID}, {ViewID: view2.ID}}, inmem.AddDashboardCellOptions{}); err != nil {
+		t.Fatalf("ReplaceDashboardCells with valid ViewIDs: %v", err)
+	}
+}
+
+func TestReplaceDashboardCells_DanglingViewID(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	view := &platform.View{Name: "view one"}
+	if err := s.CreateView(ctx, view); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	dangling := platform.ID(12345)
+	err := s.ReplaceDashboardCells(ctx, d.ID, []*platform.Cell{{ViewID: view.ID}, {ViewID: dangling}}, inmem.AddDashboardCellOptions{})
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("ReplaceDashboardCells with dangling ViewID: got %v, want EInvalid", err)
+	}
+}
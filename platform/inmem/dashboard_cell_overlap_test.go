@@ -0,0 +1,52 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestAddDashboardCell_PreventOverlap(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	view1 := &platform.View{Name: "view one"}
+	if err := s.CreateView(ctx, view1); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+	view2 := &platform.View{Name: "view two"}
+	if err := s.CreateView(ctx, view2); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+	view3 := &platform.View{Name: "view three"}
+	if err := s.CreateView(ctx, view3); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	existing := &platform.Cell{ViewID: view1.ID, CellProperty: platform.CellProperty{X: 0, Y: 0, W: 4, H: 4}}
+	if err := s.AddDashboardCell(ctx, d.ID, existing, inmem.AddDashboardCellOptions{PreventOverlap: true}); err != nil {
+		t.Fatalf("AddDashboardCell: %v", err)
+	}
+
+	t.Run("overlapping rejected", func(t *testing.T) {
+		overlapping := &platform.Cell{ViewID: view2.ID, CellProperty: platform.CellProperty{X: 2, Y: 2, W: 4, H: 4}}
+		err := s.AddDashboardCell(ctx, d.ID, overlapping, inmem.AddDashboardCellOptions{PreventOverlap: true})
+		if platform.ErrorCode(err) != platform.EInvalid {
+			t.Fatalf("got %v, want EInvalid", err)
+		}
+	})
+
+	t.Run("edge-adjacent accepted", func(t *testing.T) {
+		adjacent := &platform.Cell{ViewID: view3.ID, CellProperty: platform.CellProperty{X: 4, Y: 0, W: 4, H: 4}}
+		if err := s.AddDashboardCell(ctx, d.ID, adjacent, inmem.AddDashboardCellOptions{PreventOverlap: true}); err != nil {
+			t.Fatalf("edge-adjacent cell rejected: %v", err)
+		}
+	})
+}
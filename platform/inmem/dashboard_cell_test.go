@@ -0,0 +1,58 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestMoveDashboardCell(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{
+		Cells: []*platform.Cell{{ID: 1}, {ID: 2}, {ID: 3}},
+	}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	if err := s.MoveDashboardCell(ctx, d.ID, 1, 2); err != nil {
+		t.Fatalf("MoveDashboardCell: %v", err)
+	}
+
+	got, err := s.FindDashboardByID(ctx, d.ID)
+	if err != nil {
+		t.Fatalf("FindDashboardByID: %v", err)
+	}
+
+	want := []platform.ID{2, 3, 1}
+	if len(got.Cells) != len(want) {
+		t.Fatalf("got %d cells, want %d", len(got.Cells), len(want))
+	}
+	for i, id := range want {
+		if got.Cells[i].ID != id {
+			t.Fatalf("cell[%d].ID = %v, want %v", i, got.Cells[i].ID, id)
+		}
+	}
+}
+
+func TestMoveDashboardCell_Errors(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{Cells: []*platform.Cell{{ID: 1}}}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	if err := s.MoveDashboardCell(ctx, d.ID, 99, 0); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("MoveDashboardCell with unknown cell: got %v, want ENotFound", err)
+	}
+
+	if err := s.MoveDashboardCell(ctx, d.ID, 1, 5); platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("MoveDashboardCell with out-of-range index: got %v, want EInvalid", err)
+	}
+}
@@ -0,0 +1,55 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// CloneDashboard deep-copies the dashboard identified by id, giving the
+// clone and each of its cells fresh IDs and a new view per cell (copying
+// the source view's content). The clone shares no cell or view IDs with
+// the original.
+//
+// It touches the dashboard, view, and (via CreateDashboard) label mapping
+// stores, so it holds the service-wide mu for its whole run to keep it from
+// interleaving with another cross-resource operation (e.g. DeleteDashboard)
+// on the source dashboard.
+func (s *Service) CloneDashboard(ctx context.Context, id platform.ID, newName string) (*platform.Dashboard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	src, err := s.FindDashboardByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := &platform.Dashboard{
+		OrganizationID: src.OrganizationID,
+		Name:           newName,
+	}
+
+	for _, c := range src.Cells {
+		srcView, err := s.FindViewByID(ctx, c.ViewID)
+		if err != nil {
+			return nil, err
+		}
+
+		newView := &platform.View{Name: srcView.Name}
+		if err := s.CreateView(ctx, newView); err != nil {
+			return nil, err
+		}
+
+		clone.Cells = append(clone.Cells, &platform.Cell{
+			ID:           s.idGen.ID(),
+			ViewID:       newView.ID,
+			CellProperty: c.CellProperty,
+		})
+	}
+
+	if err := s.CreateDashboard(ctx, clone); err != nil {
+		return nil, err
+	}
+
+	return clone, nil
+}
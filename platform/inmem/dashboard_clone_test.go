@@ -0,0 +1,44 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestCloneDashboard(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	view := &platform.View{Name: "cpu"}
+	if err := s.CreateView(ctx, view); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+	src := &platform.Dashboard{Name: "original", Cells: []*platform.Cell{{ID: 1, ViewID: view.ID}}}
+	if err := s.CreateDashboard(ctx, src); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	clone, err := s.CloneDashboard(ctx, src.ID, "copy")
+	if err != nil {
+		t.Fatalf("CloneDashboard: %v", err)
+	}
+
+	if clone.ID == src.ID {
+		t.Fatalf("clone shares dashboard ID with source")
+	}
+	if clone.Name != "copy" {
+		t.Fatalf("clone.Name = %q, want %q", clone.Name, "copy")
+	}
+	if len(clone.Cells) != len(src.Cells) {
+		t.Fatalf("clone has %d cells, want %d", len(clone.Cells), len(src.Cells))
+	}
+	if clone.Cells[0].ID == src.Cells[0].ID {
+		t.Fatalf("clone cell shares ID with source cell")
+	}
+	if clone.Cells[0].ViewID == src.Cells[0].ViewID {
+		t.Fatalf("clone cell shares ViewID with source cell")
+	}
+}
@@ -0,0 +1,46 @@
+package inmem_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDashboardStore_ConcurrentReadModifyWrite(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	view := &platform.View{Name: "cpu usage"}
+	if err := s.CreateView(ctx, view); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	d := &platform.Dashboard{Cells: []*platform.Cell{{ID: 1, ViewID: view.ID}}}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := strconv.Itoa(i)
+			_, _ = s.UpdateDashboard(ctx, d.ID, platform.DashboardUpdate{Name: &name})
+			_ = s.AddDashboardCell(ctx, d.ID, &platform.Cell{ID: platform.ID(i + 2), ViewID: view.ID}, inmem.AddDashboardCellOptions{})
+		}(i)
+	}
+	wg.Wait()
+
+	got, err := s.FindDashboardByID(ctx, d.ID)
+	if err != nil {
+		t.Fatalf("FindDashboardByID: %v", err)
+	}
+	if len(got.Cells) != 51 {
+		t.Fatalf("got %d cells, want 51 (no lost updates)", len(got.Cells))
+	}
+}
@@ -0,0 +1,37 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindDashboardByID_ReturnsCopy(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{Name: "original", Cells: []*platform.Cell{{ID: 1, ViewID: 1}}}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	got, err := s.FindDashboardByID(ctx, d.ID)
+	if err != nil {
+		t.Fatalf("FindDashboardByID: %v", err)
+	}
+	got.Name = "mutated"
+	got.Cells[0].ID = 999
+
+	again, err := s.FindDashboardByID(ctx, d.ID)
+	if err != nil {
+		t.Fatalf("FindDashboardByID: %v", err)
+	}
+	if again.Name != "original" {
+		t.Fatalf("stored dashboard was mutated externally: Name = %q", again.Name)
+	}
+	if again.Cells[0].ID != 1 {
+		t.Fatalf("stored dashboard's cell was mutated externally: ID = %v", again.Cells[0].ID)
+	}
+}
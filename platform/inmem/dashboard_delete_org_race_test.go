@@ -0,0 +1,50 @@
+package inmem_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+// TestUpdateDashboard_DoesNotResurrectPurgedDashboard guards against
+// UpdateDashboard/DeleteDashboard/RestoreDashboard re-Store-ing a dashboard
+// concurrently removed by DeleteOrganization's cascade. Before both sides
+// held the same lock, this reliably resurrected the dashboard: it would
+// come back non-nil from FindDashboardByID after DeleteOrganization
+// returned, orphaned and pointing at an organization that no longer exists.
+func TestUpdateDashboard_DoesNotResurrectPurgedDashboard(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		s := inmem.NewService()
+		ctx := context.Background()
+
+		org := &platform.Organization{Name: "org"}
+		if err := s.CreateOrganization(ctx, org); err != nil {
+			t.Fatalf("CreateOrganization: %v", err)
+		}
+
+		d := &platform.Dashboard{OrganizationID: org.ID, Name: "d"}
+		if err := s.CreateDashboard(ctx, d); err != nil {
+			t.Fatalf("CreateDashboard: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = s.DeleteOrganization(ctx, org.ID)
+		}()
+		go func() {
+			defer wg.Done()
+			name := "renamed"
+			_, _ = s.UpdateDashboard(ctx, d.ID, platform.DashboardUpdate{Name: &name})
+		}()
+		wg.Wait()
+
+		if _, err := s.FindDashboardByID(ctx, d.ID); err == nil {
+			t.Fatalf("iteration %d: dashboard %v survived DeleteOrganization, resurrected by a concurrent UpdateDashboard", i, d.ID)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestUpdateDashboard_Description(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{Name: "d"}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	desc := "a helpful description"
+	got, err := s.UpdateDashboard(ctx, d.ID, platform.DashboardUpdate{Description: &desc})
+	if err != nil {
+		t.Fatalf("UpdateDashboard: %v", err)
+	}
+	if got.Description != desc {
+		t.Fatalf("Description = %q, want %q", got.Description, desc)
+	}
+}
@@ -0,0 +1,60 @@
+package inmem
+
+import (
+	"strings"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// CompiledDashboardFilter is a platform.DashboardFilter compiled into a form
+// that's cheap to evaluate against many dashboards, for callers issuing the
+// same filter repeatedly (e.g. paging through a large result set). Build one
+// with CompileDashboardFilter and reuse it across calls to Matches instead
+// of re-deriving per-call state (such as an ID lookup set) on every match.
+type CompiledDashboardFilter struct {
+	ids            map[platform.ID]bool
+	organizationID *platform.ID
+	name           string
+	includeDeleted bool
+}
+
+// CompileDashboardFilter precomputes the state a per-call filter match would
+// otherwise rebuild on every call, most notably turning filter.IDs into a
+// map for O(1) membership checks.
+func CompileDashboardFilter(filter platform.DashboardFilter) *CompiledDashboardFilter {
+	c := &CompiledDashboardFilter{
+		organizationID: filter.OrganizationID,
+		name:           strings.ToLower(filter.Name),
+		includeDeleted: filter.IncludeDeleted,
+	}
+	if len(filter.IDs) > 0 {
+		c.ids = make(map[platform.ID]bool, len(filter.IDs))
+		for _, id := range filter.IDs {
+			if id != nil {
+				c.ids[*id] = true
+			}
+		}
+	}
+	return c
+}
+
+// Matches reports whether d satisfies the filter c was compiled from.
+func (c *CompiledDashboardFilter) Matches(d *platform.Dashboard) bool {
+	if c.ids != nil && !c.ids[d.ID] {
+		return false
+	}
+
+	if c.organizationID != nil && d.OrganizationID != *c.organizationID {
+		return false
+	}
+
+	if c.name != "" && !strings.Contains(strings.ToLower(d.Name), c.name) {
+		return false
+	}
+
+	if d.Meta.DeletedAt != nil && !c.includeDeleted {
+		return false
+	}
+
+	return true
+}
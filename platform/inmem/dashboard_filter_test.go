@@ -0,0 +1,66 @@
+package inmem
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func perCallMatches(filter platform.DashboardFilter, d *platform.Dashboard) bool {
+	return CompileDashboardFilter(filter).Matches(d)
+}
+
+func TestCompiledDashboardFilter_MatchesEquivalentToPerCall(t *testing.T) {
+	orgID := platform.ID(7)
+
+	dashboards := []*platform.Dashboard{
+		{ID: 1, Name: "prod metrics", OrganizationID: orgID},
+		{ID: 2, Name: "dev metrics", OrganizationID: 99},
+		{ID: 3, Name: "prod logs", OrganizationID: orgID},
+	}
+
+	id1 := platform.ID(1)
+	id3 := platform.ID(3)
+
+	filters := []platform.DashboardFilter{
+		{},
+		{IDs: []*platform.ID{&id1, &id3}},
+		{OrganizationID: &orgID},
+		{Name: "prod"},
+		{OrganizationID: &orgID, Name: "metrics"},
+	}
+
+	for _, filter := range filters {
+		compiled := CompileDashboardFilter(filter)
+		for _, d := range dashboards {
+			got := compiled.Matches(d)
+			want := perCallMatches(filter, d)
+			if got != want {
+				t.Errorf("filter %+v, dashboard %+v: compiled.Matches()=%v, want %v", filter, d, got, want)
+			}
+		}
+	}
+}
+
+// BenchmarkDashboardFilter_CompiledVsPerCall compares reusing a single
+// CompiledDashboardFilter across many Matches calls against compiling one
+// (allocating its ID set) on every call.
+func BenchmarkDashboardFilter_CompiledVsPerCall(b *testing.B) {
+	id1, id2, id3 := platform.ID(1), platform.ID(2), platform.ID(3)
+	filter := platform.DashboardFilter{IDs: []*platform.ID{&id1, &id2, &id3}}
+	d := &platform.Dashboard{ID: 2}
+
+	b.Run("per-call", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			perCallMatches(filter, d)
+		}
+	})
+
+	b.Run("compiled", func(b *testing.B) {
+		compiled := CompileDashboardFilter(filter)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			compiled.Matches(d)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindDashboardsByLabel(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	var dashboards []*platform.Dashboard
+	for i := 0; i < 3; i++ {
+		d := &platform.Dashboard{Name: "d"}
+		if err := s.CreateDashboard(ctx, d); err != nil {
+			t.Fatalf("CreateDashboard: %v", err)
+		}
+		dashboards = append(dashboards, d)
+	}
+
+	label := &platform.Label{Name: "prod"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+
+	for _, d := range dashboards[:2] {
+		m := &platform.LabelMapping{ResourceID: d.ID, LabelID: label.ID}
+		if err := s.CreateLabelMapping(ctx, m); err != nil {
+			t.Fatalf("CreateLabelMapping: %v", err)
+		}
+	}
+
+	got, total, err := s.FindDashboardsByLabel(ctx, label.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboardsByLabel: %v", err)
+	}
+	if total != 2 || len(got) != 2 {
+		t.Fatalf("got %d dashboards (total %d), want 2", len(got), total)
+	}
+	for _, d := range got {
+		if d.ID == dashboards[2].ID {
+			t.Fatalf("unlabeled dashboard %v returned", d.ID)
+		}
+	}
+}
+
+func TestFindDashboardsByLabel_Unused(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	label := &platform.Label{Name: "unused"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+
+	got, total, err := s.FindDashboardsByLabel(ctx, label.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboardsByLabel: %v", err)
+	}
+	if total != 0 || len(got) != 0 {
+		t.Fatalf("got %d dashboards (total %d), want 0", len(got), total)
+	}
+}
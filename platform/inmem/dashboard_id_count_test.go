@@ -0,0 +1,68 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+// TestFindDashboards_SingleAndMultiIDFilterConsistency guards against the
+// single-ID and multi-ID cases of DashboardFilter.IDs diverging in sorting,
+// counting, or not-found handling: both must go through the same
+// findDashboardsByIDs path.
+func TestFindDashboards_SingleAndMultiIDFilterConsistency(t *testing.T) {
+	s := inmem.NewService()
+	ds := seedDashboards(t, s, 5)
+
+	t.Run("single ID", func(t *testing.T) {
+		got, total, err := s.FindDashboards(context.Background(), platform.DashboardFilter{IDs: []*platform.ID{&ds[2].ID}}, platform.FindOptions{})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if total != 1 || len(got) != 1 || got[0].ID != ds[2].ID {
+			t.Fatalf("got %+v (total=%d), want only %v (total=1)", got, total, ds[2].ID)
+		}
+	})
+
+	t.Run("single ID, unknown", func(t *testing.T) {
+		unknown := inmem.NewIDGenerator().ID()
+		got, total, err := s.FindDashboards(context.Background(), platform.DashboardFilter{IDs: []*platform.ID{&unknown}}, platform.FindOptions{})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if total != 0 || len(got) != 0 {
+			t.Fatalf("got %+v (total=%d), want none", got, total)
+		}
+	})
+
+	t.Run("multi ID", func(t *testing.T) {
+		ids := []*platform.ID{&ds[4].ID, &ds[0].ID, &ds[2].ID}
+		got, total, err := s.FindDashboards(context.Background(), platform.DashboardFilter{IDs: ids}, platform.FindOptions{})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if total != 3 || len(got) != 3 {
+			t.Fatalf("got %d dashboards (total=%d), want 3 (total=3)", len(got), total)
+		}
+		// Default sort is by Name, same as the single-ID and scan paths.
+		for i := 1; i < len(got); i++ {
+			if got[i-1].Name > got[i].Name {
+				t.Fatalf("multi-ID results not sorted by Name: %+v", got)
+			}
+		}
+	})
+
+	t.Run("multi ID with one unknown", func(t *testing.T) {
+		unknown := inmem.NewIDGenerator().ID()
+		ids := []*platform.ID{&ds[1].ID, &unknown}
+		got, total, err := s.FindDashboards(context.Background(), platform.DashboardFilter{IDs: ids}, platform.FindOptions{})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if total != 1 || len(got) != 1 || got[0].ID != ds[1].ID {
+			t.Fatalf("got %+v (total=%d), want only %v (total=1)", got, total, ds[1].ID)
+		}
+	})
+}
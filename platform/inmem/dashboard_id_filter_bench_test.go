@@ -0,0 +1,94 @@
+package inmem_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+// seedDashboards creates n dashboards and returns them in creation order.
+func seedDashboards(t testing.TB, s *inmem.Service, n int) []*platform.Dashboard {
+	t.Helper()
+
+	ds := make([]*platform.Dashboard, n)
+	for i := 0; i < n; i++ {
+		d := &platform.Dashboard{Name: fmt.Sprintf("dashboard-%d", i)}
+		if err := s.CreateDashboard(context.Background(), d); err != nil {
+			t.Fatalf("CreateDashboard: %v", err)
+		}
+		ds[i] = d
+	}
+	return ds
+}
+
+func TestFindDashboards_IDFilterFastPathMatchesScan(t *testing.T) {
+	s := inmem.NewService()
+	ds := seedDashboards(t, s, 50)
+
+	want := []*platform.ID{&ds[3].ID, &ds[17].ID, &ds[41].ID}
+
+	got, total, err := s.FindDashboards(context.Background(), platform.DashboardFilter{IDs: want}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	if total != 3 || len(got) != 3 {
+		t.Fatalf("got %d dashboards (total=%d), want 3 (total=3)", len(got), total)
+	}
+
+	gotIDs := map[platform.ID]bool{}
+	for _, d := range got {
+		gotIDs[d.ID] = true
+	}
+	for _, id := range want {
+		if !gotIDs[*id] {
+			t.Fatalf("fast path missing dashboard %v", *id)
+		}
+	}
+}
+
+func TestFindDashboards_IDFilterExcludesSoftDeletedUnlessRequested(t *testing.T) {
+	s := inmem.NewService()
+	ds := seedDashboards(t, s, 3)
+
+	if err := s.DeleteDashboard(context.Background(), ds[1].ID); err != nil {
+		t.Fatalf("DeleteDashboard: %v", err)
+	}
+
+	ids := []*platform.ID{&ds[0].ID, &ds[1].ID}
+
+	got, _, err := s.FindDashboards(context.Background(), platform.DashboardFilter{IDs: ids}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != ds[0].ID {
+		t.Fatalf("got %+v, want only %v", got, ds[0].ID)
+	}
+
+	gotWithDeleted, _, err := s.FindDashboards(context.Background(), platform.DashboardFilter{IDs: ids, IncludeDeleted: true}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	if len(gotWithDeleted) != 2 {
+		t.Fatalf("got %d dashboards with IncludeDeleted, want 2", len(gotWithDeleted))
+	}
+}
+
+// BenchmarkFindDashboards_IDFilter demonstrates the fast path's advantage:
+// with a large dashboard population, filtering by a handful of IDs no
+// longer costs a full scan.
+func BenchmarkFindDashboards_IDFilter(b *testing.B) {
+	s := inmem.NewService()
+	ds := seedDashboards(b, s, 100000)
+	ids := []*platform.ID{&ds[0].ID, &ds[50000].ID, &ds[99999].ID}
+	filter := platform.DashboardFilter{IDs: ids}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := s.FindDashboards(context.Background(), filter, platform.FindOptions{}); err != nil {
+			b.Fatalf("FindDashboards: %v", err)
+		}
+	}
+}
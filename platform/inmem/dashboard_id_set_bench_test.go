@@ -0,0 +1,72 @@
+package inmem
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// syncMapIDSet and its membership check exist only to benchmark against the
+// plain-map-backed CompiledDashboardFilter.ids: the filter ID set is only
+// ever read within the single goroutine running a scan, so it never needed
+// sync.Map's cross-goroutine safety, just its allocation and lookup cost.
+func newSyncMapIDSet(ids []*platform.ID) *sync.Map {
+	var m sync.Map
+	for _, id := range ids {
+		if id != nil {
+			m.Store(*id, struct{}{})
+		}
+	}
+	return &m
+}
+
+func syncMapIDSetContains(m *sync.Map, id platform.ID) bool {
+	_, ok := m.Load(id)
+	return ok
+}
+
+func TestDashboardIDSet_PlainMapMatchesSyncMap(t *testing.T) {
+	id1, id2, id3, id4 := platform.ID(1), platform.ID(2), platform.ID(3), platform.ID(4)
+	filterIDs := []*platform.ID{&id1, &id2, &id3}
+
+	compiled := CompileDashboardFilter(platform.DashboardFilter{IDs: filterIDs})
+	syncSet := newSyncMapIDSet(filterIDs)
+
+	for _, id := range []platform.ID{id1, id2, id3, id4} {
+		got := compiled.ids[id]
+		want := syncMapIDSetContains(syncSet, id)
+		if got != want {
+			t.Errorf("id %v: plain map membership=%v, sync.Map membership=%v", id, got, want)
+		}
+	}
+}
+
+// BenchmarkDashboardIDSet_PlainMapVsSyncMap shows the plain map used by
+// CompileDashboardFilter allocates and looks up cheaper than a sync.Map
+// would, for the same single-goroutine-scan use case.
+func BenchmarkDashboardIDSet_PlainMapVsSyncMap(b *testing.B) {
+	ids := make([]*platform.ID, 50)
+	for i := range ids {
+		id := platform.ID(i)
+		ids[i] = &id
+	}
+	filter := platform.DashboardFilter{IDs: ids}
+	probe := platform.ID(25)
+
+	b.Run("plain-map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			compiled := CompileDashboardFilter(filter)
+			_ = compiled.ids[probe]
+		}
+	})
+
+	b.Run("sync.Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			set := newSyncMapIDSet(ids)
+			_ = syncMapIDSetContains(set, probe)
+		}
+	})
+}
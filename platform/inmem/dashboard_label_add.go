@@ -0,0 +1,26 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// AddDashboardLabels attaches every label in labelIDs to dashboardID in one
+// call. It validates each label exists before creating its mapping; a
+// missing label doesn't stop the rest, since every error is aggregated
+// into a MultiError instead of failing the whole call at the first one.
+// Attaching a label that's already attached to dashboardID is idempotent.
+func (s *Service) AddDashboardLabels(ctx context.Context, dashboardID platform.ID, labelIDs []platform.ID) error {
+	var errs platform.MultiError
+	for _, labelID := range labelIDs {
+		if _, err := s.FindLabelByID(ctx, labelID); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := s.CreateLabelMapping(ctx, &platform.LabelMapping{ResourceID: dashboardID, LabelID: labelID}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs.ErrOrNil()
+}
@@ -0,0 +1,76 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestAddDashboardLabels_PartialSuccess(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{Name: "my dashboard"}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	a := &platform.Label{Name: "a"}
+	b := &platform.Label{Name: "b"}
+	if err := s.CreateLabel(ctx, a); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	if err := s.CreateLabel(ctx, b); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	invalidLabel := platform.ID(999999)
+
+	err := s.AddDashboardLabels(ctx, d.ID, []platform.ID{a.ID, invalidLabel, b.ID})
+	if err == nil {
+		t.Fatalf("expected an error reporting the invalid label, got nil")
+	}
+	if _, ok := err.(platform.MultiError); !ok {
+		t.Fatalf("got error of type %T, want platform.MultiError", err)
+	}
+	if len(err.(platform.MultiError)) != 1 {
+		t.Fatalf("got %d errors, want exactly 1 for the invalid label: %v", len(err.(platform.MultiError)), err)
+	}
+
+	mappings, _, err := s.FindLabelMappings(ctx, d.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindLabelMappings: %v", err)
+	}
+	if len(mappings) != 2 {
+		t.Fatalf("got %d mappings, want the 2 valid labels attached despite the invalid one: %v", len(mappings), mappings)
+	}
+}
+
+func TestAddDashboardLabels_DuplicateAttachIdempotent(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{Name: "my dashboard"}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	label := &platform.Label{Name: "env"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+
+	if err := s.AddDashboardLabels(ctx, d.ID, []platform.ID{label.ID}); err != nil {
+		t.Fatalf("first AddDashboardLabels: %v", err)
+	}
+	if err := s.AddDashboardLabels(ctx, d.ID, []platform.ID{label.ID}); err != nil {
+		t.Fatalf("second AddDashboardLabels should be idempotent, got %v", err)
+	}
+
+	mappings, _, err := s.FindLabelMappings(ctx, d.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindLabelMappings: %v", err)
+	}
+	if len(mappings) != 1 {
+		t.Fatalf("got %d mappings, want exactly 1", len(mappings))
+	}
+}
@@ -0,0 +1,28 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindDashboards_FilterByNameSubstring(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	for _, name := range []string{"Prod Overview", "Staging Overview", "Team Health"} {
+		if err := s.CreateDashboard(ctx, &platform.Dashboard{Name: name}); err != nil {
+			t.Fatalf("CreateDashboard: %v", err)
+		}
+	}
+
+	got, _, err := s.FindDashboards(ctx, platform.DashboardFilter{Name: "overview"}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d dashboards, want 2", len(got))
+	}
+}
@@ -0,0 +1,40 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindDashboards_FilterByOrganizationID(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org1 := platform.ID(1)
+	org2 := platform.ID(2)
+
+	for _, d := range []*platform.Dashboard{
+		{Name: "a", OrganizationID: org1},
+		{Name: "b", OrganizationID: org1},
+		{Name: "c", OrganizationID: org2},
+	} {
+		if err := s.CreateDashboard(ctx, d); err != nil {
+			t.Fatalf("CreateDashboard: %v", err)
+		}
+	}
+
+	got, total, err := s.FindDashboards(ctx, platform.DashboardFilter{OrganizationID: &org1}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	if total != 2 || len(got) != 2 {
+		t.Fatalf("got %d dashboards (total=%d), want 2 (total=2)", len(got), total)
+	}
+	for _, d := range got {
+		if d.OrganizationID != org1 {
+			t.Fatalf("got dashboard in org %v, want %v", d.OrganizationID, org1)
+		}
+	}
+}
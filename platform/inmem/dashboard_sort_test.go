@@ -0,0 +1,118 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+// dashboardWithMeta creates a dashboard then overwrites its stored
+// CreatedAt/UpdatedAt directly, since CreateDashboard always stamps them
+// with the current time and UpdateDashboard always bumps UpdatedAt to the
+// current time. CreateDashboard stores the same *Dashboard it's given, so
+// mutating d's Meta after the call updates the stored value too.
+func dashboardWithMeta(t *testing.T, s *inmem.Service, name string, createdAt, updatedAt time.Time) *platform.Dashboard {
+	t.Helper()
+
+	d := &platform.Dashboard{Name: name}
+	if err := s.CreateDashboard(context.Background(), d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	d.Meta.CreatedAt = createdAt
+	d.Meta.UpdatedAt = updatedAt
+	return d
+}
+
+func TestFindDashboards_SortByCreatedAt(t *testing.T) {
+	s := inmem.NewService()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	older := dashboardWithMeta(t, s, "older", base, base)
+	newer := dashboardWithMeta(t, s, "newer", base.Add(time.Hour), base.Add(time.Hour))
+
+	t.Run("ascending", func(t *testing.T) {
+		got, _, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{SortBy: "CreatedAt"})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if got[0].ID != older.ID || got[1].ID != newer.ID {
+			t.Fatalf("got order %q, %q; want older, newer", got[0].Name, got[1].Name)
+		}
+	})
+
+	t.Run("descending", func(t *testing.T) {
+		got, _, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{SortBy: "CreatedAt", Descending: true})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if got[0].ID != newer.ID || got[1].ID != older.ID {
+			t.Fatalf("got order %q, %q; want newer, older", got[0].Name, got[1].Name)
+		}
+	})
+}
+
+func TestFindDashboards_SortByUpdatedAt(t *testing.T) {
+	s := inmem.NewService()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	stale := dashboardWithMeta(t, s, "stale", base, base)
+	fresh := dashboardWithMeta(t, s, "fresh", base, base.Add(time.Hour))
+
+	t.Run("ascending", func(t *testing.T) {
+		got, _, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{SortBy: "UpdatedAt"})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if got[0].ID != stale.ID || got[1].ID != fresh.ID {
+			t.Fatalf("got order %q, %q; want stale, fresh", got[0].Name, got[1].Name)
+		}
+	})
+
+	t.Run("descending", func(t *testing.T) {
+		got, _, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{SortBy: "UpdatedAt", Descending: true})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if got[0].ID != fresh.ID || got[1].ID != stale.ID {
+			t.Fatalf("got order %q, %q; want fresh, stale", got[0].Name, got[1].Name)
+		}
+	})
+}
+
+func TestFindDashboards_SortTieBreaksOnID(t *testing.T) {
+	s := inmem.NewService()
+	same := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	a := dashboardWithMeta(t, s, "a", same, same)
+	b := dashboardWithMeta(t, s, "b", same, same)
+
+	first, second := a, b
+	if b.ID < a.ID {
+		first, second = b, a
+	}
+
+	for _, sortBy := range []string{"CreatedAt", "UpdatedAt"} {
+		t.Run(sortBy+" ascending", func(t *testing.T) {
+			got, _, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{SortBy: sortBy})
+			if err != nil {
+				t.Fatalf("FindDashboards: %v", err)
+			}
+			if got[0].ID != first.ID || got[1].ID != second.ID {
+				t.Fatalf("equal-timestamp tie not broken by ID ascending: got %v, %v", got[0].ID, got[1].ID)
+			}
+		})
+
+		t.Run(sortBy+" descending", func(t *testing.T) {
+			got, _, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{SortBy: sortBy, Descending: true})
+			if err != nil {
+				t.Fatalf("FindDashboards: %v", err)
+			}
+			if got[0].ID != first.ID || got[1].ID != second.ID {
+				t.Fatalf("equal-timestamp tie not broken by ID ascending even when descending: got %v, %v", got[0].ID, got[1].ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package inmem_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestStreamDashboards_OneObjectPerLine(t *testing.T) {
+	s := inmem.NewService()
+	want := newDashboards(t, s, 3)
+
+	var buf bytes.Buffer
+	if err := s.StreamDashboards(context.Background(), platform.DashboardFilter{}, &buf); err != nil {
+		t.Fatalf("StreamDashboards: %v", err)
+	}
+
+	scanner := bufio.NewScanner(&buf)
+	var got []*platform.Dashboard
+	for scanner.Scan() {
+		var d platform.Dashboard
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			t.Fatalf("Unmarshal line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, &d)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d dashboards, want %d", len(got), len(want))
+	}
+	for i, d := range got {
+		if d.ID != want[i].ID || d.Name != want[i].Name {
+			t.Fatalf("line %d = %+v, want %+v", i, d, want[i])
+		}
+	}
+}
+
+func TestStreamDashboards_FiltersLikeFindDashboards(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := platform.ID(42)
+	if err := s.CreateDashboard(ctx, &platform.Dashboard{Name: "in-org", OrganizationID: org}); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	if err := s.CreateDashboard(ctx, &platform.Dashboard{Name: "other-org", OrganizationID: platform.ID(99)}); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := s.StreamDashboards(ctx, platform.DashboardFilter{OrganizationID: &org}, &buf); err != nil {
+		t.Fatalf("StreamDashboards: %v", err)
+	}
+
+	scanner := bufio.NewScanner(&buf)
+	var lines int
+	for scanner.Scan() {
+		lines++
+		if !bytes.Contains(scanner.Bytes(), []byte(`"in-org"`)) {
+			t.Fatalf("unexpected line: %s", scanner.Text())
+		}
+	}
+	if lines != 1 {
+		t.Fatalf("got %d lines, want 1", lines)
+	}
+}
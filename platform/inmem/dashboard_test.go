@@ -0,0 +1,67 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func newDashboards(t *testing.T, s *inmem.Service, n int) []*platform.Dashboard {
+	t.Helper()
+
+	ds := make([]*platform.Dashboard, n)
+	for i := 0; i < n; i++ {
+		d := &platform.Dashboard{Name: string(rune('a' + i))}
+		if err := s.CreateDashboard(context.Background(), d); err != nil {
+			t.Fatalf("CreateDashboard: %v", err)
+		}
+		ds[i] = d
+	}
+	return ds
+}
+
+func TestFindDashboards_LimitOffset(t *testing.T) {
+	s := inmem.NewService()
+	newDashboards(t, s, 5)
+
+	t.Run("zero limit means no limit", func(t *testing.T) {
+		got, total, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if total != 5 || len(got) != 5 {
+			t.Fatalf("got %d dashboards (total=%d), want 5 (total=5)", len(got), total)
+		}
+	})
+
+	t.Run("offset beyond end returns empty with true total", func(t *testing.T) {
+		got, total, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{Offset: 100})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if total != 5 {
+			t.Fatalf("total = %d, want 5", total)
+		}
+		if len(got) != 0 {
+			t.Fatalf("got %d dashboards, want 0", len(got))
+		}
+	})
+
+	t.Run("limit and offset window the sorted results", func(t *testing.T) {
+		got, total, err := s.FindDashboards(context.Background(), platform.DashboardFilter{}, platform.FindOptions{Limit: 2, Offset: 1})
+		if err != nil {
+			t.Fatalf("FindDashboards: %v", err)
+		}
+		if total != 5 {
+			t.Fatalf("total = %d, want 5", total)
+		}
+		if len(got) != 2 {
+			t.Fatalf("got %d dashboards, want 2", len(got))
+		}
+		if got[0].Name != "b" || got[1].Name != "c" {
+			t.Fatalf("got names %q, %q; want %q, %q", got[0].Name, got[1].Name, "b", "c")
+		}
+	})
+}
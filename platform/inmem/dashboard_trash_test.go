@@ -0,0 +1,105 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDashboardTrash_DeleteThenRestore(t *testing.T) {
+	ctx := context.Background()
+	s := inmem.NewService()
+
+	d := &platform.Dashboard{Name: "trashed-and-restored"}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	if err := s.DeleteDashboard(ctx, d.ID); err != nil {
+		t.Fatalf("DeleteDashboard: %v", err)
+	}
+
+	got, err := s.FindDashboardByID(ctx, d.ID)
+	if err != nil {
+		t.Fatalf("FindDashboardByID: %v", err)
+	}
+	if got.Meta.DeletedAt == nil {
+		t.Fatalf("expected DeletedAt to be set after delete")
+	}
+
+	found, _, err := s.FindDashboards(ctx, platform.DashboardFilter{}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	for _, fd := range found {
+		if fd.ID == d.ID {
+			t.Fatalf("soft-deleted dashboard appeared in default FindDashboards results")
+		}
+	}
+
+	foundWithDeleted, _, err := s.FindDashboards(ctx, platform.DashboardFilter{IncludeDeleted: true}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	var seen bool
+	for _, fd := range foundWithDeleted {
+		if fd.ID == d.ID {
+			seen = true
+		}
+	}
+	if !seen {
+		t.Fatalf("expected soft-deleted dashboard to appear with IncludeDeleted: true")
+	}
+
+	if err := s.RestoreDashboard(ctx, d.ID); err != nil {
+		t.Fatalf("RestoreDashboard: %v", err)
+	}
+
+	got, err = s.FindDashboardByID(ctx, d.ID)
+	if err != nil {
+		t.Fatalf("FindDashboardByID: %v", err)
+	}
+	if got.Meta.DeletedAt != nil {
+		t.Fatalf("expected DeletedAt to be cleared after restore")
+	}
+
+	found, _, err = s.FindDashboards(ctx, platform.DashboardFilter{}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	seen = false
+	for _, fd := range found {
+		if fd.ID == d.ID {
+			seen = true
+		}
+	}
+	if !seen {
+		t.Fatalf("expected restored dashboard to appear in default FindDashboards results")
+	}
+}
+
+func TestDashboardTrash_DeleteThenPurge(t *testing.T) {
+	ctx := context.Background()
+	s := inmem.NewService()
+
+	d := &platform.Dashboard{Name: "trashed-and-purged"}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	if err := s.DeleteDashboard(ctx, d.ID); err != nil {
+		t.Fatalf("DeleteDashboard: %v", err)
+	}
+	if err := s.PurgeDashboard(ctx, d.ID); err != nil {
+		t.Fatalf("PurgeDashboard: %v", err)
+	}
+
+	if _, err := s.FindDashboardByID(ctx, d.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got %v, want ENotFound after purge", err)
+	}
+	if err := s.RestoreDashboard(ctx, d.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got %v, want ENotFound restoring a purged dashboard", err)
+	}
+}
@@ -0,0 +1,41 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// FindDashboardWithViews returns a dashboard with each cell's view resolved
+// and embedded. If any cell references a view that no longer exists, it
+// returns an error rather than silently omitting it.
+//
+// It reads across the dashboard and view stores, so it takes the
+// service-wide mu for read to avoid observing a half-applied write from a
+// concurrent cross-resource operation (e.g. CloneDashboard or
+// DeleteDashboard).
+func (s *Service) FindDashboardWithViews(ctx context.Context, id platform.ID) (*platform.DashboardWithViews, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	d, err := s.FindDashboardByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make(map[platform.ID]*platform.View, len(d.Cells))
+	for _, c := range d.Cells {
+		v, err := s.FindViewByID(ctx, c.ViewID)
+		if err != nil {
+			return nil, &platform.Error{
+				Code: platform.ENotFound,
+				Msg:  "view referenced by cell not found",
+				Op:   s.op("FindDashboardWithViews"),
+				Err:  err,
+			}
+		}
+		views[c.ViewID] = v
+	}
+
+	return &platform.DashboardWithViews{Dashboard: d, Views: views}, nil
+}
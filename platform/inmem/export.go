@@ -0,0 +1,114 @@
+package inmem
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// orgExport is the on-disk representation produced by ExportOrg and
+// consumed by ImportOrg.
+type orgExport struct {
+	Organization *platform.Organization    `json:"organization"`
+	Buckets      []*platform.Bucket        `json:"buckets"`
+	Dashboards   []*platform.Dashboard     `json:"dashboards"`
+	Targets      []*platform.ScraperTarget `json:"scraperTargets"`
+	Labels       []*platform.Label         `json:"labels"`
+}
+
+// ExportOrg serializes an organization and its child resources (buckets,
+// dashboards, and scraper targets) to JSON. Labels, which aren't scoped to
+// an organization, are included unfiltered.
+func (s *Service) ExportOrg(ctx context.Context, orgID platform.ID) ([]byte, error) {
+	org, err := s.FindOrganizationByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, _, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &orgID}, platform.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	dashboards, _, err := s.FindDashboards(ctx, platform.DashboardFilter{OrganizationID: &orgID}, platform.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	targets, _, err := s.FindTargets(ctx, platform.ScraperTargetFilter{OrganizationID: &orgID}, platform.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.FindLabels(ctx, platform.LabelFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(orgExport{
+		Organization: org,
+		Buckets:      buckets,
+		Dashboards:   dashboards,
+		Targets:      targets,
+		Labels:       labels,
+	})
+}
+
+// ImportOrg restores an organization and its child resources previously
+// produced by ExportOrg. If newOrgID is non-nil, the organization and all
+// child resources are remapped to that ID instead of their original one.
+func (s *Service) ImportOrg(ctx context.Context, data []byte, newOrgID *platform.ID) error {
+	var exp orgExport
+	if err := json.Unmarshal(data, &exp); err != nil {
+		return &platform.Error{Code: platform.EInvalid, Msg: "invalid export data", Op: s.op("ImportOrg"), Err: err}
+	}
+
+	orgID := exp.Organization.ID
+	if newOrgID != nil {
+		orgID = *newOrgID
+	}
+
+	org := *exp.Organization
+	org.ID = orgID
+	if err := s.CreateOrganization(ctx, &org); err != nil {
+		return err
+	}
+
+	for _, b := range exp.Buckets {
+		cp := *b
+		cp.ID = 0
+		cp.OrganizationID = orgID
+		if err := s.CreateBucket(ctx, &cp); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range exp.Dashboards {
+		cp := *d
+		cp.ID = 0
+		cp.OrganizationID = orgID
+		if err := s.CreateDashboard(ctx, &cp); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range exp.Targets {
+		cp := *t
+		cp.ID = 0
+		cp.OrganizationID = orgID
+		if err := s.AddTarget(ctx, &cp); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range exp.Labels {
+		cp := *l
+		cp.ID = 0
+		if err := s.CreateLabel(ctx, &cp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
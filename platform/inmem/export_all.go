@@ -0,0 +1,174 @@
+package inmem
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// serviceExportVersion identifies the schema of the envelope Export
+// produces, so a future incompatible change can detect and reject (or
+// migrate) an older snapshot instead of silently misreading it.
+const serviceExportVersion = 1
+
+// serviceExport is the on-disk representation produced by Export and
+// consumed by Import: a Service-wide snapshot of the resource kinds listed in
+// its fields, for debugging and test fixtures. It's distinct from orgExport,
+// which scopes to a single organization's child resources.
+//
+// Authorizations and user-resource mappings are not part of the envelope:
+// Export does not write them, Import does not restore them, and empty does
+// not look at them.
+type serviceExport struct {
+	Version         int                        `json:"version"`
+	Organizations   []*platform.Organization   `json:"organizations"`
+	Buckets         []*platform.Bucket         `json:"buckets"`
+	Users           []*platform.User           `json:"users"`
+	Dashboards      []*platform.Dashboard      `json:"dashboards"`
+	Views           []*platform.View           `json:"views"`
+	TelegrafConfigs []*platform.TelegrafConfig `json:"telegrafConfigs"`
+	ScraperTargets  []*platform.ScraperTarget  `json:"scraperTargets"`
+	Labels          []*platform.Label          `json:"labels"`
+	LabelMappings   []*platform.LabelMapping   `json:"labelMappings"`
+}
+
+// Export serializes the Service's organizations, buckets, users, dashboards,
+// views, Telegraf configs, scraper targets, labels, and label mappings into
+// a versioned JSON envelope, preserving IDs. Authorizations and
+// user-resource mappings are not included. Pair with Import to snapshot and
+// restore those resources.
+func (s *Service) Export(ctx context.Context) ([]byte, error) {
+	orgs, err := s.FindOrganizations(ctx, platform.OrganizationFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, _, err := s.FindBuckets(ctx, platform.BucketFilter{}, platform.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.FindUsers(ctx, platform.UserFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	dashboards, _, err := s.FindDashboards(ctx, platform.DashboardFilter{IncludeDeleted: true}, platform.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var views []*platform.View
+	s.viewKV.Range(func(k, v interface{}) bool {
+		views = append(views, v.(*platform.View))
+		return true
+	})
+
+	telegrafConfigs, _, err := s.FindTelegrafConfigs(ctx, platform.TelegrafConfigFilter{}, platform.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	targets, _, err := s.FindTargets(ctx, platform.ScraperTargetFilter{}, platform.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.FindLabels(ctx, platform.LabelFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	var labelMappings []*platform.LabelMapping
+	s.labelMappingKV.Range(func(k, v interface{}) bool {
+		labelMappings = append(labelMappings, v.(*platform.LabelMapping))
+		return true
+	})
+
+	return json.Marshal(serviceExport{
+		Version:         serviceExportVersion,
+		Organizations:   orgs,
+		Buckets:         buckets,
+		Users:           users,
+		Dashboards:      dashboards,
+		Views:           views,
+		TelegrafConfigs: telegrafConfigs,
+		ScraperTargets:  targets,
+		Labels:          labels,
+		LabelMappings:   labelMappings,
+	})
+}
+
+// Import restores a snapshot previously produced by Export, preserving the
+// original IDs. Unless overwrite is true, importing into a Service that
+// already holds any resource fails with EConflict rather than silently
+// merging or clobbering existing data.
+func (s *Service) Import(ctx context.Context, data []byte, overwrite bool) error {
+	if !overwrite && !s.empty() {
+		return &platform.Error{
+			Code: platform.EConflict,
+			Msg:  "import target is not empty; pass overwrite to import anyway",
+			Op:   s.op("Import"),
+		}
+	}
+
+	var exp serviceExport
+	if err := json.Unmarshal(data, &exp); err != nil {
+		return &platform.Error{Code: platform.EInvalid, Msg: "invalid export data", Op: s.op("Import"), Err: err}
+	}
+
+	for _, o := range exp.Organizations {
+		s.organizationKV.Store(o.ID, o)
+	}
+	for _, b := range exp.Buckets {
+		s.bucketKV.Store(b.ID, b)
+	}
+	for _, u := range exp.Users {
+		s.userKV.Store(u.ID, u)
+	}
+	for _, d := range exp.Dashboards {
+		s.dashboardKV.Store(d.ID, d)
+	}
+	for _, v := range exp.Views {
+		s.viewKV.Store(v.ID, v)
+	}
+	for _, tc := range exp.TelegrafConfigs {
+		s.telegrafKV.Store(tc.ID, tc)
+	}
+	for _, t := range exp.ScraperTargets {
+		s.scraperKV.Store(t.ID, t)
+	}
+	for _, l := range exp.Labels {
+		s.labelKV.Store(l.ID, l)
+	}
+	for _, m := range exp.LabelMappings {
+		key := labelMappingKey{resourceID: m.ResourceID, labelID: m.LabelID}
+		s.labelMappingKV.Store(key, m)
+	}
+
+	return nil
+}
+
+// empty reports whether the Service holds no resources of any kind Export
+// would serialize.
+func (s *Service) empty() bool {
+	empty := true
+	check := func(m *sync.Map) {
+		m.Range(func(k, v interface{}) bool {
+			empty = false
+			return false
+		})
+	}
+	check(&s.organizationKV)
+	check(&s.bucketKV)
+	check(&s.userKV)
+	check(&s.dashboardKV)
+	check(&s.viewKV)
+	check(&s.telegrafKV)
+	check(&s.scraperKV)
+	check(&s.labelKV)
+	check(&s.labelMappingKV)
+	return empty
+}
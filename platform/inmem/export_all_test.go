@@ -0,0 +1,119 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := &platform.Organization{Name: "acme"}
+	if err := s.CreateOrganization(ctx, org); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	bucket := &platform.Bucket{Name: "metrics", OrganizationID: org.ID}
+	if err := s.CreateBucket(ctx, bucket); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	user := &platform.User{Name: "ada"}
+	if err := s.CreateUser(ctx, user); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	dashboard := &platform.Dashboard{Name: "overview", OrganizationID: org.ID}
+	if err := s.CreateDashboard(ctx, dashboard); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	view := &platform.View{Name: "cpu"}
+	if err := s.CreateView(ctx, view); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+	telegraf := &platform.TelegrafConfig{Name: "agent", OrganizationID: org.ID}
+	if err := s.CreateTelegrafConfig(ctx, telegraf); err != nil {
+		t.Fatalf("CreateTelegrafConfig: %v", err)
+	}
+	target := &platform.ScraperTarget{Name: "scraper", OrganizationID: org.ID}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	label := &platform.Label{Name: "team:acme"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	if err := s.CreateLabelMapping(ctx, &platform.LabelMapping{LabelID: label.ID, ResourceID: dashboard.ID}); err != nil {
+		t.Fatalf("CreateLabelMapping: %v", err)
+	}
+
+	data, err := s.Export(ctx)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	fresh := inmem.NewService()
+	if err := fresh.Import(ctx, data, false); err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+
+	if _, err := fresh.FindOrganizationByID(ctx, org.ID); err != nil {
+		t.Errorf("FindOrganizationByID: %v", err)
+	}
+	if _, err := fresh.FindBucketByID(ctx, bucket.ID); err != nil {
+		t.Errorf("FindBucketByID: %v", err)
+	}
+	if _, err := fresh.FindUserByID(ctx, user.ID); err != nil {
+		t.Errorf("FindUserByID: %v", err)
+	}
+	if _, err := fresh.FindDashboardByID(ctx, dashboard.ID); err != nil {
+		t.Errorf("FindDashboardByID: %v", err)
+	}
+	if _, err := fresh.FindViewByID(ctx, view.ID); err != nil {
+		t.Errorf("FindViewByID: %v", err)
+	}
+	if _, err := fresh.FindTelegrafConfigByID(ctx, telegraf.ID); err != nil {
+		t.Errorf("FindTelegrafConfigByID: %v", err)
+	}
+	if _, err := fresh.FindTargetByID(ctx, target.ID); err != nil {
+		t.Errorf("FindTargetByID: %v", err)
+	}
+	if _, err := fresh.FindLabelByID(ctx, label.ID); err != nil {
+		t.Errorf("FindLabelByID: %v", err)
+	}
+	mappings, _, err := fresh.FindLabelMappings(ctx, dashboard.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindLabelMappings: %v", err)
+	}
+	if len(mappings) != 1 {
+		t.Errorf("got %+v, want the one label mapping to survive the round trip", mappings)
+	}
+}
+
+func TestImport_NonEmptyServiceRequiresOverwrite(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.CreateOrganization(ctx, &platform.Organization{Name: "acme"}); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	data, err := s.Export(ctx)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	nonEmpty := inmem.NewService()
+	if err := nonEmpty.CreateOrganization(ctx, &platform.Organization{Name: "existing"}); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	err = nonEmpty.Import(ctx, data, false)
+	if platform.ErrorCode(err) != platform.EConflict {
+		t.Fatalf("got err %v, want EConflict", err)
+	}
+
+	if err := nonEmpty.Import(ctx, data, true); err != nil {
+		t.Fatalf("Import with overwrite: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestExportImportOrg_RoundTrip(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org1 := &platform.Organization{Name: "org1"}
+	org2 := &platform.Organization{Name: "org2"}
+	if err := s.CreateOrganization(ctx, org1); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	if err := s.CreateOrganization(ctx, org2); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "b1", OrganizationID: org1.ID}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "b2", OrganizationID: org2.ID}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if err := s.CreateDashboard(ctx, &platform.Dashboard{Name: "d1", OrganizationID: org1.ID}); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	if err := s.CreateDashboard(ctx, &platform.Dashboard{Name: "d2", OrganizationID: org2.ID}); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	data, err := s.ExportOrg(ctx, org1.ID)
+	if err != nil {
+		t.Fatalf("ExportOrg: %v", err)
+	}
+
+	fresh := inmem.NewService()
+	if err := fresh.ImportOrg(ctx, data, nil); err != nil {
+		t.Fatalf("ImportOrg: %v", err)
+	}
+
+	buckets, _, err := fresh.FindBuckets(ctx, platform.BucketFilter{}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindBuckets: %v", err)
+	}
+	if len(buckets) != 1 || buckets[0].Name != "b1" {
+		t.Fatalf("got buckets %+v, want only org1's bucket", buckets)
+	}
+
+	dashboards, _, err := fresh.FindDashboards(ctx, platform.DashboardFilter{}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	if len(dashboards) != 1 || dashboards[0].Name != "d1" {
+		t.Fatalf("got dashboards %+v, want only org1's dashboard", dashboards)
+	}
+}
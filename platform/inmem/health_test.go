@@ -0,0 +1,31 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestHealth_NewServiceIsHealthy(t *testing.T) {
+	s := inmem.NewService()
+	check, err := s.Health(context.Background())
+	if err != nil {
+		t.Fatalf("Health: %v", err)
+	}
+	if check.Status != platform.Healthy {
+		t.Fatalf("got status %q, want %q", check.Status, platform.Healthy)
+	}
+}
+
+func TestHealth_ZeroValueServiceIsUnavailable(t *testing.T) {
+	var s inmem.Service
+	check, err := s.Health(context.Background())
+	if platform.ErrorCode(err) != platform.EUnavailable {
+		t.Fatalf("got error %v, want EUnavailable", err)
+	}
+	if check.Status != platform.Unhealthy {
+		t.Fatalf("got status %q, want %q", check.Status, platform.Unhealthy)
+	}
+}
@@ -0,0 +1,29 @@
+package inmem
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// idGenerator generates IDs by reading from crypto/rand.
+type idGenerator struct{}
+
+// NewIDGenerator returns a platform.IDGenerator that produces random,
+// non-zero IDs.
+func NewIDGenerator() platform.IDGenerator {
+	return &idGenerator{}
+}
+
+func (g *idGenerator) ID() platform.ID {
+	for {
+		var b [8]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			continue
+		}
+		if id := platform.ID(binary.BigEndian.Uint64(b[:])); id.Valid() {
+			return id
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+	"github.com/influxdata/influxdb/platform/mock"
+)
+
+func TestWithIDGenerator_SequenceProducesDistinctIDs(t *testing.T) {
+	s := inmem.NewService(inmem.WithIDGenerator(mock.NewSequenceIDGenerator()))
+	ctx := context.Background()
+
+	a := &platform.Dashboard{Name: "a"}
+	b := &platform.Dashboard{Name: "b"}
+	c := &platform.Dashboard{Name: "c"}
+	for _, d := range []*platform.Dashboard{a, b, c} {
+		if err := s.CreateDashboard(ctx, d); err != nil {
+			t.Fatalf("CreateDashboard: %v", err)
+		}
+	}
+
+	if a.ID == b.ID || b.ID == c.ID || a.ID == c.ID {
+		t.Fatalf("got non-distinct IDs: %v, %v, %v", a.ID, b.ID, c.ID)
+	}
+}
@@ -0,0 +1,59 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.LabelService = (*Service)(nil)
+
+// FindLabelByID returns a single label by ID.
+func (s *Service) FindLabelByID(ctx context.Context, id platform.ID) (*platform.Label, error) {
+	v, ok := s.labelKV.Load(id)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "label not found",
+			Op:   s.op("FindLabelByID"),
+		}
+	}
+	return v.(*platform.Label), nil
+}
+
+// FindLabels returns all labels matching filter.
+func (s *Service) FindLabels(ctx context.Context, filter platform.LabelFilter) ([]*platform.Label, error) {
+	var labels []*platform.Label
+	s.labelKV.Range(func(k, v interface{}) bool {
+		l := v.(*platform.Label)
+		if filter.Matches(l) {
+			labels = append(labels, l)
+		}
+		return true
+	})
+	return labels, nil
+}
+
+// CreateLabel creates a new label, assigning it an ID if it doesn't already
+// have one.
+func (s *Service) CreateLabel(ctx context.Context, l *platform.Label) error {
+	if err := platform.ValidateName(s.op("CreateLabel"), l.Name); err != nil {
+		return err
+	}
+
+	if !l.ID.Valid() {
+		l.ID = s.idGen.ID()
+	}
+	s.labelKV.Store(l.ID, l)
+	return nil
+}
+
+// DeleteLabel removes a label by ID.
+func (s *Service) DeleteLabel(ctx context.Context, id platform.ID) error {
+	if _, err := s.FindLabelByID(ctx, id); err != nil {
+		return err
+	}
+	s.labelKV.Delete(id)
+	s.invalidateName(platform.LabelResourceType, id)
+	return nil
+}
@@ -0,0 +1,68 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.LabelMappingService = (*Service)(nil)
+
+type labelMappingKey struct {
+	resourceID platform.ID
+	labelID    platform.ID
+}
+
+// CreateLabelMapping associates a label with a resource.
+func (s *Service) CreateLabelMapping(ctx context.Context, m *platform.LabelMapping) error {
+	key := labelMappingKey{resourceID: m.ResourceID, labelID: m.LabelID}
+	s.labelMappingKV.Store(key, m)
+	return nil
+}
+
+// DeleteLabelMapping removes the association between a label and a
+// resource. It is idempotent: deleting a mapping that's already gone (for
+// example because it was concurrently removed while its resource was being
+// deleted) is not an error.
+func (s *Service) DeleteLabelMapping(ctx context.Context, m *platform.LabelMapping) error {
+	key := labelMappingKey{resourceID: m.ResourceID, labelID: m.LabelID}
+	s.labelMappingKV.Delete(key)
+	return nil
+}
+
+// FindLabelMappings returns the page of label mappings for a resource
+// selected by opts, along with the total count of matching mappings before
+// paging is applied.
+func (s *Service) FindLabelMappings(ctx context.Context, resourceID platform.ID, opts platform.FindOptions) ([]*platform.LabelMapping, int, error) {
+	if err := opts.Valid(); err != nil {
+		return nil, 0, err
+	}
+
+	var mappings []*platform.LabelMapping
+	s.labelMappingKV.Range(func(k, v interface{}) bool {
+		key := k.(labelMappingKey)
+		if key.resourceID == resourceID {
+			mappings = append(mappings, v.(*platform.LabelMapping))
+		}
+		return true
+	})
+
+	less := idLess(opts.Descending)
+	sort.Slice(mappings, func(i, j int) bool { return less(mappings[i].LabelID, mappings[j].LabelID) })
+
+	total := len(mappings)
+
+	if opts.Offset > 0 {
+		if opts.Offset >= len(mappings) {
+			return []*platform.LabelMapping{}, total, nil
+		}
+		mappings = mappings[opts.Offset:]
+	}
+
+	if opts.Limit > 0 && opts.Limit < len(mappings) {
+		mappings = mappings[:opts.Limit]
+	}
+
+	return mappings, total, nil
+}
@@ -0,0 +1,45 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestPurgeDashboard_CascadesLabelMappings(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	label := &platform.Label{Name: "env", Key: "env", Value: "prod"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	m := &platform.LabelMapping{ResourceID: d.ID, LabelID: label.ID}
+	if err := s.CreateLabelMapping(ctx, m); err != nil {
+		t.Fatalf("CreateLabelMapping: %v", err)
+	}
+
+	// Simulate a concurrent deletion of the same mapping racing with the
+	// dashboard purge: it should not cause PurgeDashboard to error.
+	if err := s.DeleteLabelMapping(ctx, m); err != nil {
+		t.Fatalf("DeleteLabelMapping: %v", err)
+	}
+
+	if err := s.PurgeDashboard(ctx, d.ID); err != nil {
+		t.Fatalf("PurgeDashboard: %v", err)
+	}
+
+	mappings, _, err := s.FindLabelMappings(ctx, d.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindLabelMappings: %v", err)
+	}
+	if len(mappings) != 0 {
+		t.Fatalf("got %d mappings after purge, want 0", len(mappings))
+	}
+}
@@ -0,0 +1,93 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindLabels_KeyValue(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	labels := []*platform.Label{
+		{Name: "prod env", Key: "env", Value: "prod"},
+		{Name: "staging env", Key: "env", Value: "staging"},
+		{Name: "team", Key: "team", Value: "prod"},
+	}
+	for _, l := range labels {
+		if err := s.CreateLabel(ctx, l); err != nil {
+			t.Fatalf("CreateLabel: %v", err)
+		}
+	}
+
+	t.Run("filter by key", func(t *testing.T) {
+		got, err := s.FindLabels(ctx, platform.LabelFilter{Key: "env"})
+		if err != nil {
+			t.Fatalf("FindLabels: %v", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("got %d labels, want 2", len(got))
+		}
+	})
+
+	t.Run("filter by key and value", func(t *testing.T) {
+		got, err := s.FindLabels(ctx, platform.LabelFilter{Key: "env", Value: "prod"})
+		if err != nil {
+			t.Fatalf("FindLabels: %v", err)
+		}
+		if len(got) != 1 || got[0].Name != "prod env" {
+			t.Fatalf("got %+v, want a single label named %q", got, "prod env")
+		}
+	})
+}
+
+func TestFindLabels_FiltersByColor(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	red := &platform.Label{Name: "urgent", Color: "#ff0000"}
+	blue := &platform.Label{Name: "info", Color: "#0000ff"}
+	alsoRed := &platform.Label{Name: "hot", Color: "#ff0000"}
+	for _, l := range []*platform.Label{red, blue, alsoRed} {
+		if err := s.CreateLabel(ctx, l); err != nil {
+			t.Fatalf("CreateLabel: %v", err)
+		}
+	}
+
+	got, err := s.FindLabels(ctx, platform.LabelFilter{Color: "#ff0000"})
+	if err != nil {
+		t.Fatalf("FindLabels: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d labels, want 2", len(got))
+	}
+	for _, l := range got {
+		if l.Color != "#ff0000" {
+			t.Errorf("got label with color %q, want #ff0000", l.Color)
+		}
+	}
+}
+
+func TestFindLabels_FiltersByNameAndColorCombined(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	target := &platform.Label{Name: "urgent", Color: "#ff0000"}
+	sameName := &platform.Label{Name: "urgent", Color: "#00ff00"}
+	for _, l := range []*platform.Label{target, sameName} {
+		if err := s.CreateLabel(ctx, l); err != nil {
+			t.Fatalf("CreateLabel: %v", err)
+		}
+	}
+
+	got, err := s.FindLabels(ctx, platform.LabelFilter{Name: "urgent", Color: "#ff0000"})
+	if err != nil {
+		t.Fatalf("FindLabels: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != target.ID {
+		t.Fatalf("got %v, want only %v", got, target.ID)
+	}
+}
@@ -0,0 +1,115 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.LookupService = (*Service)(nil)
+
+// Name resolves the display name of a resource by type and ID. It covers
+// dashboards, organizations, buckets, labels, scraper targets, views, and
+// users; any other resource type fails with EInvalid.
+//
+// If the Service was constructed with WithNameCache, results are served
+// from and populated into that cache; it's invalidated by the relevant
+// Update/Delete/Rename operations so a rename is never masked by a stale
+// cached name.
+func (s *Service) Name(ctx context.Context, resourceType platform.ResourceType, id platform.ID) (string, error) {
+	if s.nameCache != nil {
+		if name, ok := s.nameCache.get(nameCacheKey{resourceType, id}); ok {
+			return name, nil
+		}
+	}
+
+	name, err := s.lookupName(ctx, resourceType, id)
+	if err != nil {
+		return "", err
+	}
+
+	if s.nameCache != nil {
+		s.nameCache.set(nameCacheKey{resourceType, id}, name)
+	}
+	return name, nil
+}
+
+// invalidateName evicts a resource's cached name, if a name cache is
+// configured. Called by Update/Delete/Rename operations that could
+// otherwise leave a stale name in the cache.
+func (s *Service) invalidateName(resourceType platform.ResourceType, id platform.ID) {
+	if s.nameCache != nil {
+		s.nameCache.invalidate(nameCacheKey{resourceType, id})
+	}
+}
+
+func (s *Service) lookupName(ctx context.Context, resourceType platform.ResourceType, id platform.ID) (string, error) {
+	switch resourceType {
+	case platform.DashboardResourceType:
+		d, err := s.FindDashboardByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return d.Name, nil
+	case platform.OrganizationResourceType:
+		o, err := s.FindOrganizationByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return o.Name, nil
+	case platform.BucketResourceType:
+		b, err := s.FindBucketByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return b.Name, nil
+	case platform.LabelResourceType:
+		l, err := s.FindLabelByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return l.Name, nil
+	case platform.ScraperResourceType:
+		t, err := s.FindTargetByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return t.Name, nil
+	case platform.ViewResourceType:
+		v, err := s.FindViewByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return v.Name, nil
+	case platform.UserResourceType:
+		u, err := s.FindUserByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return u.Name, nil
+	default:
+		return "", &platform.Error{
+			Code: platform.EInvalid,
+			Msg:  "unknown resource type: " + string(resourceType),
+			Op:   s.op("Name"),
+		}
+	}
+}
+
+// Names resolves the display names of many resources of the same type at
+// once, skipping any ID that doesn't resolve to an existing resource rather
+// than failing the whole call.
+func (s *Service) Names(ctx context.Context, resourceType platform.ResourceType, ids []platform.ID) (map[platform.ID]string, error) {
+	names := make(map[platform.ID]string, len(ids))
+	for _, id := range ids {
+		name, err := s.Name(ctx, resourceType, id)
+		if err != nil {
+			if platform.ErrorCode(err) == platform.ENotFound {
+				continue
+			}
+			return nil, err
+		}
+		names[id] = name
+	}
+	return names, nil
+}
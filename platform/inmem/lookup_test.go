@@ -0,0 +1,94 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestServiceName(t *testing.T) {
+	ctx := context.Background()
+	s := inmem.NewService()
+
+	target := &platform.ScraperTarget{Name: "my-scraper"}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	view := &platform.View{Name: "my-view"}
+	if err := s.CreateView(ctx, view); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	user := &platform.User{Name: "my-user"}
+	if err := s.CreateUser(ctx, user); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	tests := []struct {
+		resourceType platform.ResourceType
+		id           platform.ID
+		want         string
+	}{
+		{platform.ScraperResourceType, target.ID, "my-scraper"},
+		{platform.ViewResourceType, view.ID, "my-view"},
+		{platform.UserResourceType, user.ID, "my-user"},
+	}
+
+	for _, tt := range tests {
+		got, err := s.Name(ctx, tt.resourceType, tt.id)
+		if err != nil {
+			t.Fatalf("Name(%v, %v): %v", tt.resourceType, tt.id, err)
+		}
+		if got != tt.want {
+			t.Fatalf("Name(%v, %v) = %q, want %q", tt.resourceType, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestServiceNamesMixedExistence(t *testing.T) {
+	ctx := context.Background()
+	s := inmem.NewService()
+
+	a := &platform.ScraperTarget{Name: "target-a"}
+	b := &platform.ScraperTarget{Name: "target-b"}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if err := s.AddTarget(ctx, b); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	missing := platform.ID(1)
+	names, err := s.Names(ctx, platform.ScraperResourceType, []platform.ID{a.ID, missing, b.ID})
+	if err != nil {
+		t.Fatalf("Names: %v", err)
+	}
+
+	want := map[platform.ID]string{a.ID: "target-a", b.ID: "target-b"}
+	if len(names) != len(want) {
+		t.Fatalf("got %v, want %v", names, want)
+	}
+	for id, name := range want {
+		if names[id] != name {
+			t.Fatalf("names[%v] = %q, want %q", id, names[id], name)
+		}
+	}
+	if _, ok := names[missing]; ok {
+		t.Fatalf("names contains missing ID %v", missing)
+	}
+}
+
+func TestServiceNameNotFound(t *testing.T) {
+	ctx := context.Background()
+	s := inmem.NewService()
+
+	if _, err := s.Name(ctx, platform.ScraperResourceType, platform.ID(1)); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got %v, want ENotFound", err)
+	}
+	if _, err := s.Name(ctx, platform.ResourceType("bogus"), platform.ID(1)); platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("got %v, want EInvalid", err)
+	}
+}
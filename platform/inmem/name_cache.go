@@ -0,0 +1,94 @@
+package inmem
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// nameCacheKey identifies a single Service.Name result.
+type nameCacheKey struct {
+	resourceType platform.ResourceType
+	id           platform.ID
+}
+
+type nameCacheEntry struct {
+	key       nameCacheKey
+	name      string
+	expiresAt time.Time
+}
+
+// nameCache is a small LRU cache with a fixed TTL for Service.Name lookups.
+// It exists to avoid a full map lookup on hot audit paths that re-resolve
+// the same resource name repeatedly; it is opt-in via WithNameCache since
+// most tests and simple uses don't need it.
+type nameCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[nameCacheKey]*list.Element
+	order   *list.List // most-recently-used at the front
+}
+
+func newNameCache(size int, ttl time.Duration) *nameCache {
+	return &nameCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[nameCacheKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *nameCache) get(key nameCacheKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	entry := el.Value.(*nameCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return "", false
+	}
+	c.order.MoveToFront(el)
+	return entry.name, true
+}
+
+func (c *nameCache) set(key nameCacheKey, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*nameCacheEntry).name = name
+		el.Value.(*nameCacheEntry).expiresAt = time.Now().Add(c.ttl)
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&nameCacheEntry{key: key, name: name, expiresAt: time.Now().Add(c.ttl)})
+	c.entries[key] = el
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		if oldest == nil {
+			break
+		}
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*nameCacheEntry).key)
+	}
+}
+
+func (c *nameCache) invalidate(key nameCacheKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.order.Remove(el)
+		delete(c.entries, key)
+	}
+}
@@ -0,0 +1,150 @@
+package inmem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestNameCache_GetSetInvalidate(t *testing.T) {
+	c := newNameCache(10, time.Minute)
+	key := nameCacheKey{platform.ScraperResourceType, platform.ID(1)}
+
+	if _, ok := c.get(key); ok {
+		t.Fatalf("expected miss on empty cache")
+	}
+
+	c.set(key, "a")
+	if got, ok := c.get(key); !ok || got != "a" {
+		t.Fatalf("get = %q, %v; want a, true", got, ok)
+	}
+
+	c.invalidate(key)
+	if _, ok := c.get(key); ok {
+		t.Fatalf("expected miss after invalidate")
+	}
+}
+
+func TestNameCache_TTLExpiry(t *testing.T) {
+	c := newNameCache(10, time.Millisecond)
+	key := nameCacheKey{platform.ScraperResourceType, platform.ID(1)}
+
+	c.set(key, "a")
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.get(key); ok {
+		t.Fatalf("expected miss after TTL expiry")
+	}
+}
+
+func TestNameCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := newNameCache(2, time.Minute)
+	k1 := nameCacheKey{platform.ScraperResourceType, platform.ID(1)}
+	k2 := nameCacheKey{platform.ScraperResourceType, platform.ID(2)}
+	k3 := nameCacheKey{platform.ScraperResourceType, platform.ID(3)}
+
+	c.set(k1, "1")
+	c.set(k2, "2")
+	c.get(k1) // touch k1 so k2 is the least-recently-used
+	c.set(k3, "3")
+
+	if _, ok := c.get(k2); ok {
+		t.Fatalf("expected k2 to be evicted")
+	}
+	if _, ok := c.get(k1); !ok {
+		t.Fatalf("expected k1 to survive eviction")
+	}
+	if _, ok := c.get(k3); !ok {
+		t.Fatalf("expected k3 to survive eviction")
+	}
+}
+
+func TestServiceName_CacheHit(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(WithNameCache(10, time.Minute))
+
+	target := &platform.ScraperTarget{Name: "cached-target"}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if _, err := s.Name(ctx, platform.ScraperResourceType, target.ID); err != nil {
+		t.Fatalf("Name: %v", err)
+	}
+
+	// Mutate the store directly, bypassing UpdateTarget's cache
+	// invalidation, to prove the second Name call is served from cache
+	// rather than recomputed from the store.
+	s.scraperKV.Store(target.ID, &platform.ScraperTarget{ID: target.ID, Name: "changed-behind-the-cache"})
+
+	got, err := s.Name(ctx, platform.ScraperResourceType, target.ID)
+	if err != nil {
+		t.Fatalf("Name: %v", err)
+	}
+	if got != "cached-target" {
+		t.Fatalf("got %q, want cached-target (should be served from cache)", got)
+	}
+}
+
+func TestServiceName_InvalidatedAfterRename(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(WithNameCache(10, time.Minute))
+
+	target := &platform.ScraperTarget{Name: "old-name"}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if got, err := s.Name(ctx, platform.ScraperResourceType, target.ID); err != nil || got != "old-name" {
+		t.Fatalf("Name = %q, %v; want old-name, nil", got, err)
+	}
+
+	if _, err := s.RenameTarget(ctx, target.ID, "new-name"); err != nil {
+		t.Fatalf("RenameTarget: %v", err)
+	}
+
+	got, err := s.Name(ctx, platform.ScraperResourceType, target.ID)
+	if err != nil {
+		t.Fatalf("Name: %v", err)
+	}
+	if got != "new-name" {
+		t.Fatalf("got stale name %q, want new-name", got)
+	}
+}
+
+func TestServiceName_TTLExpiryRefreshesFromStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewService(WithNameCache(10, time.Millisecond))
+
+	target := &platform.ScraperTarget{Name: "short-lived"}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if _, err := s.Name(ctx, platform.ScraperResourceType, target.ID); err != nil {
+		t.Fatalf("Name: %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	s.scraperKV.Store(target.ID, &platform.ScraperTarget{ID: target.ID, Name: "renamed-directly"})
+
+	got, err := s.Name(ctx, platform.ScraperResourceType, target.ID)
+	if err != nil {
+		t.Fatalf("Name: %v", err)
+	}
+	if got != "renamed-directly" {
+		t.Fatalf("got %q, want renamed-directly (expired entry should refresh)", got)
+	}
+}
+
+func TestServiceName_CacheDisabledByDefault(t *testing.T) {
+	ctx := context.Background()
+	s := NewService()
+
+	target := &platform.ScraperTarget{Name: "no-cache"}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if got, err := s.Name(ctx, platform.ScraperResourceType, target.ID); err != nil || got != "no-cache" {
+		t.Fatalf("Name = %q, %v; want no-cache, nil", got, err)
+	}
+}
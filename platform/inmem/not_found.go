@@ -0,0 +1,16 @@
+package inmem
+
+import (
+	"github.com/influxdata/influxdb/platform"
+)
+
+// notFound builds a uniform ENotFound error for a missing resource, so the
+// FindXByID methods that use it (dashboards, organizations, buckets, users)
+// report the same message shape instead of each hand-rolling its own wording.
+func notFound(op string, resource platform.ResourceType, id platform.ID) *platform.Error {
+	return &platform.Error{
+		Code: platform.ENotFound,
+		Msg:  string(resource) + " \"" + id.String() + "\" not found",
+		Op:   op,
+	}
+}
@@ -0,0 +1,41 @@
+package inmem_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestNotFoundMessageFormat(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	id := platform.ID(0x111)
+
+	cases := []struct {
+		name string
+		err  error
+	}{
+		{"dashboard", errFrom(s.FindDashboardByID(ctx, id))},
+		{"bucket", errFrom(s.FindBucketByID(ctx, id))},
+		{"user", errFrom(s.FindUserByID(ctx, id))},
+	}
+
+	for _, c := range cases {
+		if platform.ErrorCode(c.err) != platform.ENotFound {
+			t.Errorf("%s: err = %v, want ENotFound", c.name, c.err)
+			continue
+		}
+		want := c.name + ` "` + id.String() + `" not found`
+		if !strings.Contains(c.err.Error(), want) {
+			t.Errorf("%s: err = %q, want it to contain %q", c.name, c.err.Error(), want)
+		}
+	}
+}
+
+func errFrom(v interface{}, err error) error {
+	return err
+}
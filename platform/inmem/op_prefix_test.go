@@ -0,0 +1,27 @@
+package inmem_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestCreateDashboard_ErrorOpIncludesPrefix(t *testing.T) {
+	s := inmem.NewService()
+
+	err := s.CreateDashboard(context.Background(), &platform.Dashboard{Name: strings.Repeat("x", 257)})
+	if err == nil {
+		t.Fatal("expected error for too-long name, got nil")
+	}
+
+	perr, ok := err.(*platform.Error)
+	if !ok {
+		t.Fatalf("err is %T, want *platform.Error", err)
+	}
+	if !strings.HasPrefix(perr.Op, inmem.OpPrefix) {
+		t.Fatalf("Op = %q, want prefix %q", perr.Op, inmem.OpPrefix)
+	}
+}
@@ -0,0 +1,68 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestOrgSummary_CountsOwnedResources(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := &platform.Organization{Name: "org1"}
+	if err := s.CreateOrganization(ctx, org); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	other := &platform.Organization{Name: "org2"}
+	if err := s.CreateOrganization(ctx, other); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	for _, name := range []string{"d1", "d2"} {
+		if err := s.CreateDashboard(ctx, &platform.Dashboard{Name: name, OrganizationID: org.ID}); err != nil {
+			t.Fatalf("CreateDashboard: %v", err)
+		}
+	}
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "b1", OrganizationID: org.ID}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if err := s.CreateBucket(ctx, &platform.Bucket{Name: "b-other", OrganizationID: other.ID}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if err := s.AddTarget(ctx, &platform.ScraperTarget{
+		Name:           "t1",
+		URL:            "http://example.com",
+		OrganizationID: org.ID,
+	}); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	summary, err := s.OrgSummary(ctx, org.ID)
+	if err != nil {
+		t.Fatalf("OrgSummary: %v", err)
+	}
+
+	if summary.DashboardCount != 2 {
+		t.Errorf("DashboardCount = %d, want 2", summary.DashboardCount)
+	}
+	if summary.BucketCount != 1 {
+		t.Errorf("BucketCount = %d, want 1", summary.BucketCount)
+	}
+	if summary.ScraperTargetCount != 1 {
+		t.Errorf("ScraperTargetCount = %d, want 1", summary.ScraperTargetCount)
+	}
+	if summary.TelegrafConfigCount != 0 {
+		t.Errorf("TelegrafConfigCount = %d, want 0", summary.TelegrafConfigCount)
+	}
+}
+
+func TestOrgSummary_UnknownOrgReturnsNotFound(t *testing.T) {
+	s := inmem.NewService()
+	_, err := s.OrgSummary(context.Background(), platform.ID(1))
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("err = %v, want ENotFound", err)
+	}
+}
@@ -0,0 +1,195 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.OrganizationService = (*Service)(nil)
+
+// FindOrganizationByID returns a single organization by ID.
+func (s *Service) FindOrganizationByID(ctx context.Context, id platform.ID) (*platform.Organization, error) {
+	v, ok := s.organizationKV.Load(id)
+	if !ok {
+		return nil, notFound(s.op("FindOrganizationByID"), platform.OrganizationResourceType, id)
+	}
+	return v.(*platform.Organization), nil
+}
+
+// FindOrganizations returns all organizations matching filter.
+func (s *Service) FindOrganizations(ctx context.Context, filter platform.OrganizationFilter) ([]*platform.Organization, error) {
+	var orgs []*platform.Organization
+	s.organizationKV.Range(func(k, v interface{}) bool {
+		o := v.(*platform.Organization)
+		if filter.ID != nil && o.ID != *filter.ID {
+			return true
+		}
+		if filter.Name != nil && o.Name != *filter.Name {
+			return true
+		}
+		orgs = append(orgs, o)
+		return true
+	})
+	return orgs, nil
+}
+
+// CreateOrganization creates a new organization, assigning it an ID if it
+// doesn't already have one. The name must not already be in use by another
+// organization.
+func (s *Service) CreateOrganization(ctx context.Context, o *platform.Organization) error {
+	if err := platform.ValidateName(s.op("CreateOrganization"), o.Name); err != nil {
+		return err
+	}
+
+	if s.organizationNameInUse(o.Name, nil) {
+		return &platform.Error{
+			Code: platform.EConflict,
+			Msg:  "organization with name " + o.Name + " already exists",
+			Op:   s.op("CreateOrganization"),
+		}
+	}
+
+	if !o.ID.Valid() {
+		o.ID = s.idGen.ID()
+	}
+	s.organizationKV.Store(o.ID, o)
+	return nil
+}
+
+// UpdateOrganization applies upd to the organization with the given ID. A
+// rename into a name already in use by another organization fails with
+// EConflict.
+func (s *Service) UpdateOrganization(ctx context.Context, id platform.ID, upd platform.OrganizationUpdate) (*platform.Organization, error) {
+	o, err := s.FindOrganizationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if upd.Name != nil {
+		if err := platform.ValidateName(s.op("UpdateOrganization"), *upd.Name); err != nil {
+			return nil, err
+		}
+		if s.organizationNameInUse(*upd.Name, &id) {
+			return nil, &platform.Error{
+				Code: platform.EConflict,
+				Msg:  "organization with name " + *upd.Name + " already exists",
+				Op:   s.op("UpdateOrganization"),
+			}
+		}
+		o.Name = *upd.Name
+	}
+
+	s.organizationKV.Store(o.ID, o)
+	s.invalidateName(platform.OrganizationResourceType, o.ID)
+	return o, nil
+}
+
+// organizationNameInUse reports whether name is already used by an
+// organization other than excludeID (if non-nil). Matching is
+// case-sensitive, matching FindOrganizations' existing Name behavior.
+func (s *Service) organizationNameInUse(name string, excludeID *platform.ID) bool {
+	inUse := false
+	s.organizationKV.Range(func(k, v interface{}) bool {
+		o := v.(*platform.Organization)
+		if excludeID != nil && o.ID == *excludeID {
+			return true
+		}
+		if o.Name == name {
+			inUse = true
+			return false
+		}
+		return true
+	})
+	return inUse
+}
+
+// OrgSummary returns aggregate counts of resources owned by the
+// organization with the given ID, or ENotFound if it doesn't exist.
+func (s *Service) OrgSummary(ctx context.Context, id platform.ID) (platform.OrgSummary, error) {
+	if _, err := s.FindOrganizationByID(ctx, id); err != nil {
+		return platform.OrgSummary{}, err
+	}
+
+	var summary platform.OrgSummary
+
+	s.dashboardKV.Range(func(k, v interface{}) bool {
+		if v.(*platform.Dashboard).OrganizationID == id {
+			summary.DashboardCount++
+		}
+		return true
+	})
+	s.bucketKV.Range(func(k, v interface{}) bool {
+		if v.(*platform.Bucket).OrganizationID == id {
+			summary.BucketCount++
+		}
+		return true
+	})
+	s.telegrafKV.Range(func(k, v interface{}) bool {
+		if v.(*platform.TelegrafConfig).OrganizationID == id {
+			summary.TelegrafConfigCount++
+		}
+		return true
+	})
+	s.scraperKV.Range(func(k, v interface{}) bool {
+		if v.(*platform.ScraperTarget).OrganizationID == id {
+			summary.ScraperTargetCount++
+		}
+		return true
+	})
+
+	return summary, nil
+}
+
+// DeleteOrganization removes an organization by ID, along with every bucket
+// and dashboard (and, transitively, the dashboards' label mappings) that
+// belongs to it, so neither name lookups nor permission checks are left
+// pointing at an orphaned resource.
+//
+// Cascade cleanup is best-effort: it keeps going past an individual
+// failure and aggregates every error into a MultiError rather than stopping
+// at the first one, so one bad record can't prevent cleanup of the rest.
+//
+// It touches the organization, bucket, dashboard, and label mapping stores,
+// so it holds the service-wide mu for its whole run, the same as
+// PurgeDashboard and CloneDashboard.
+func (s *Service) DeleteOrganization(ctx context.Context, id platform.ID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.FindOrganizationByID(ctx, id); err != nil {
+		return err
+	}
+
+	var errs platform.MultiError
+
+	var bucketIDs []platform.ID
+	s.bucketKV.Range(func(k, v interface{}) bool {
+		if b := v.(*platform.Bucket); b.OrganizationID == id {
+			bucketIDs = append(bucketIDs, b.ID)
+		}
+		return true
+	})
+	for _, bucketID := range bucketIDs {
+		s.bucketKV.Delete(bucketID)
+		s.invalidateName(platform.BucketResourceType, bucketID)
+	}
+
+	var dashboardIDs []platform.ID
+	s.dashboardKV.Range(func(k, v interface{}) bool {
+		if d := v.(*platform.Dashboard); d.OrganizationID == id {
+			dashboardIDs = append(dashboardIDs, d.ID)
+		}
+		return true
+	})
+	for _, dashboardID := range dashboardIDs {
+		if err := s.purgeDashboardLocked(ctx, dashboardID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	s.organizationKV.Delete(id)
+	s.invalidateName(platform.OrganizationResourceType, id)
+
+	return errs.ErrOrNil()
+}
@@ -0,0 +1,90 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDeleteOrganization_CascadesBucketsAndDashboards(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := &platform.Organization{Name: "acme"}
+	if err := s.CreateOrganization(ctx, org); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	otherOrg := &platform.Organization{Name: "globex"}
+	if err := s.CreateOrganization(ctx, otherOrg); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	bucket := &platform.Bucket{Name: "metrics", OrganizationID: org.ID}
+	if err := s.CreateBucket(ctx, bucket); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	otherBucket := &platform.Bucket{Name: "other-metrics", OrganizationID: otherOrg.ID}
+	if err := s.CreateBucket(ctx, otherBucket); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+
+	dashboard := &platform.Dashboard{Name: "overview", OrganizationID: org.ID}
+	if err := s.CreateDashboard(ctx, dashboard); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	otherDashboard := &platform.Dashboard{Name: "other-overview", OrganizationID: otherOrg.ID}
+	if err := s.CreateDashboard(ctx, otherDashboard); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	label := &platform.Label{Name: "team:acme"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	if err := s.CreateLabelMapping(ctx, &platform.LabelMapping{LabelID: label.ID, ResourceID: dashboard.ID}); err != nil {
+		t.Fatalf("CreateLabelMapping: %v", err)
+	}
+
+	if err := s.DeleteOrganization(ctx, org.ID); err != nil {
+		t.Fatalf("DeleteOrganization: %v", err)
+	}
+
+	if _, err := s.FindBucketByID(ctx, bucket.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got err %v for deleted org's bucket, want ENotFound", err)
+	}
+	if _, err := s.FindDashboardByID(ctx, dashboard.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got err %v for deleted org's dashboard, want ENotFound", err)
+	}
+
+	mappings, _, err := s.FindLabelMappings(ctx, dashboard.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindLabelMappings: %v", err)
+	}
+	if len(mappings) != 0 {
+		t.Fatalf("got %+v, want no label mappings left for the deleted dashboard", mappings)
+	}
+
+	// The other organization's resources are untouched.
+	if _, err := s.FindBucketByID(ctx, otherBucket.ID); err != nil {
+		t.Fatalf("other org's bucket was unexpectedly removed: %v", err)
+	}
+	if _, err := s.FindDashboardByID(ctx, otherDashboard.ID); err != nil {
+		t.Fatalf("other org's dashboard was unexpectedly removed: %v", err)
+	}
+}
+
+func TestDeleteOrganization_NoResourcesIsNoOp(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := &platform.Organization{Name: "empty-org"}
+	if err := s.CreateOrganization(ctx, org); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	if err := s.DeleteOrganization(ctx, org.ID); err != nil {
+		t.Fatalf("DeleteOrganization: %v", err)
+	}
+}
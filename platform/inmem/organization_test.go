@@ -0,0 +1,92 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestCreateOrganization_DuplicateNameConflicts(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.CreateOrganization(ctx, &platform.Organization{Name: "acme"}); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	err := s.CreateOrganization(ctx, &platform.Organization{Name: "acme"})
+	if platform.ErrorCode(err) != platform.EConflict {
+		t.Fatalf("got err %v, want EConflict", err)
+	}
+}
+
+func TestCreateOrganization_CaseSensitiveNameAllowed(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.CreateOrganization(ctx, &platform.Organization{Name: "acme"}); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	if err := s.CreateOrganization(ctx, &platform.Organization{Name: "ACME"}); err != nil {
+		t.Fatalf("CreateOrganization with different case should not conflict: %v", err)
+	}
+}
+
+func TestUpdateOrganization_RenameIntoExistingNameConflicts(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.CreateOrganization(ctx, &platform.Organization{Name: "acme"}); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	other := &platform.Organization{Name: "globex"}
+	if err := s.CreateOrganization(ctx, other); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	newName := "acme"
+	_, err := s.UpdateOrganization(ctx, other.ID, platform.OrganizationUpdate{Name: &newName})
+	if platform.ErrorCode(err) != platform.EConflict {
+		t.Fatalf("got err %v, want EConflict", err)
+	}
+}
+
+func TestUpdateOrganization_RenameToOwnNameSucceeds(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	o := &platform.Organization{Name: "acme"}
+	if err := s.CreateOrganization(ctx, o); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	sameName := "acme"
+	updated, err := s.UpdateOrganization(ctx, o.ID, platform.OrganizationUpdate{Name: &sameName})
+	if err != nil {
+		t.Fatalf("UpdateOrganization: %v", err)
+	}
+	if updated.Name != "acme" {
+		t.Fatalf("got name %q, want %q", updated.Name, "acme")
+	}
+}
+
+func TestUpdateOrganization_RenameToUnusedNameSucceeds(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	o := &platform.Organization{Name: "acme"}
+	if err := s.CreateOrganization(ctx, o); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	newName := "acme-renamed"
+	updated, err := s.UpdateOrganization(ctx, o.ID, platform.OrganizationUpdate{Name: &newName})
+	if err != nil {
+		t.Fatalf("UpdateOrganization: %v", err)
+	}
+	if updated.Name != newName {
+		t.Fatalf("got name %q, want %q", updated.Name, newName)
+	}
+}
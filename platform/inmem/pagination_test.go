@@ -0,0 +1,67 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindTargets_TotalCountExceedsPageLength(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if err := s.AddTarget(ctx, &platform.ScraperTarget{Name: string(rune('a' + i))}); err != nil {
+			t.Fatalf("AddTarget: %v", err)
+		}
+	}
+
+	got, total, err := s.FindTargets(ctx, platform.ScraperTargetFilter{}, platform.FindOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("FindTargets: %v", err)
+	}
+	if total != 5 {
+		t.Fatalf("total = %d, want 5", total)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d targets, want 2", len(got))
+	}
+	if total <= len(got) {
+		t.Fatalf("total (%d) should exceed the truncated page length (%d)", total, len(got))
+	}
+}
+
+func TestFindLabelMappings_TotalCountExceedsPageLength(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	d := &platform.Dashboard{}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		label := &platform.Label{Name: string(rune('a' + i)), Key: "k", Value: string(rune('a' + i))}
+		if err := s.CreateLabel(ctx, label); err != nil {
+			t.Fatalf("CreateLabel: %v", err)
+		}
+		if err := s.CreateLabelMapping(ctx, &platform.LabelMapping{ResourceID: d.ID, LabelID: label.ID}); err != nil {
+			t.Fatalf("CreateLabelMapping: %v", err)
+		}
+	}
+
+	got, total, err := s.FindLabelMappings(ctx, d.ID, platform.FindOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("FindLabelMappings: %v", err)
+	}
+	if total != 5 {
+		t.Fatalf("total = %d, want 5", total)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d mappings, want 2", len(got))
+	}
+	if total <= len(got) {
+		t.Fatalf("total (%d) should exceed the truncated page length (%d)", total, len(got))
+	}
+}
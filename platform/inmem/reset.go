@@ -0,0 +1,23 @@
+package inmem
+
+import "sync"
+
+// Reset clears all resources held by the service, giving tests a clean
+// slate without reallocating the Service or its ID generator. It must not
+// be called while other operations are in flight.
+func (s *Service) Reset() {
+	s.dashboardKV = sync.Map{}
+	s.labelKV = sync.Map{}
+	s.organizationKV = sync.Map{}
+	s.bucketKV = sync.Map{}
+	s.scraperKV = sync.Map{}
+	s.viewKV = sync.Map{}
+	s.labelMappingKV = sync.Map{}
+	s.userKV = sync.Map{}
+	s.authorizationKV = sync.Map{}
+	s.authorizationByToken = sync.Map{}
+	s.telegrafKV = sync.Map{}
+	s.urmKV = sync.Map{}
+	s.dashboardCountCache.invalidate()
+	s.targetCountCache.invalidate()
+}
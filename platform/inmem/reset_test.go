@@ -0,0 +1,39 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestService_Reset(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.CreateDashboard(ctx, &platform.Dashboard{Name: "a"}); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	if err := s.CreateLabel(ctx, &platform.Label{Name: "env", Key: "env", Value: "prod"}); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+
+	s.Reset()
+
+	dashboards, total, err := s.FindDashboards(ctx, platform.DashboardFilter{}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindDashboards: %v", err)
+	}
+	if total != 0 || len(dashboards) != 0 {
+		t.Fatalf("got %d dashboards (total=%d) after Reset, want 0", len(dashboards), total)
+	}
+
+	labels, err := s.FindLabels(ctx, platform.LabelFilter{})
+	if err != nil {
+		t.Fatalf("FindLabels: %v", err)
+	}
+	if len(labels) != 0 {
+		t.Fatalf("got %d labels after Reset, want 0", len(labels))
+	}
+}
@@ -0,0 +1,366 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.ScraperTargetStoreService = (*Service)(nil)
+
+// FindTargetByID returns a single scraper target by ID.
+func (s *Service) FindTargetByID(ctx context.Context, id platform.ID) (*platform.ScraperTarget, error) {
+	v, ok := s.scraperKV.Load(id)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "scraper target not found",
+			Op:   s.op("FindTargetByID"),
+		}
+	}
+	return v.(*platform.ScraperTarget), nil
+}
+
+// FindTargets returns the page of scraper targets matching filter selected
+// by opts, along with the total count of matching targets before paging is
+// applied.
+func (s *Service) FindTargets(ctx context.Context, filter platform.ScraperTargetFilter, opts platform.FindOptions) ([]*platform.ScraperTarget, int, error) {
+	if err := opts.Valid(); err != nil {
+		return nil, 0, err
+	}
+
+	var targets []*platform.ScraperTarget
+	s.scraperKV.Range(func(k, v interface{}) bool {
+		t := v.(*platform.ScraperTarget)
+		if scraperTargetMatches(t, filter) {
+			targets = append(targets, t)
+		}
+		return true
+	})
+
+	less := idLess(opts.Descending)
+	sort.Slice(targets, func(i, j int) bool { return less(targets[i].ID, targets[j].ID) })
+
+	total := len(targets)
+
+	if opts.Offset > 0 {
+		if opts.Offset >= len(targets) {
+			return []*platform.ScraperTarget{}, total, nil
+		}
+		targets = targets[opts.Offset:]
+	}
+
+	if opts.Limit > 0 && opts.Limit < len(targets) {
+		targets = targets[:opts.Limit]
+	}
+
+	return targets, total, nil
+}
+
+// scraperTargetMatches reports whether t satisfies every non-nil field of
+// filter.
+func scraperTargetMatches(t *platform.ScraperTarget, filter platform.ScraperTargetFilter) bool {
+	if filter.OrganizationID != nil && t.OrganizationID != *filter.OrganizationID {
+		return false
+	}
+	if filter.BucketID != nil && t.BucketID != *filter.BucketID {
+		return false
+	}
+	if filter.Name != nil && t.Name != *filter.Name {
+		return false
+	}
+	if filter.Type != nil && t.EffectiveType() != *filter.Type {
+		return false
+	}
+	return true
+}
+
+// AddTarget creates a new scraper target, assigning it an ID if it doesn't
+// already have one. The name must not already be in use by another target
+// in the same org.
+func (s *Service) AddTarget(ctx context.Context, t *platform.ScraperTarget) error {
+	if err := s.resolveTargetRefs(ctx, t, s.op("AddTarget")); err != nil {
+		return err
+	}
+	if err := platform.ValidateName(s.op("AddTarget"), t.Name); err != nil {
+		return err
+	}
+	if err := platform.ValidateDescription(s.op("AddTarget"), t.Description); err != nil {
+		return err
+	}
+	if err := platform.ValidateMetricLists(s.op("AddTarget"), t.MetricAllowlist, t.MetricDenylist); err != nil {
+		return err
+	}
+	if err := platform.ValidateHeaders(s.op("AddTarget"), t.Headers); err != nil {
+		return err
+	}
+	if s.targetNameInUse(t.OrganizationID, t.Name, nil) {
+		return &platform.Error{
+			Code: platform.EConflict,
+			Msg:  "scraper target with name " + t.Name + " already exists for this organization",
+			Op:   s.op("AddTarget"),
+		}
+	}
+
+	if !t.ID.Valid() {
+		t.ID = s.idGen.ID()
+	}
+	now := s.timeGen.Now()
+	t.Meta.CreatedAt = now
+	t.Meta.UpdatedAt = now
+	s.scraperKV.Store(t.ID, t)
+	s.targetCountCache.invalidate()
+	return nil
+}
+
+// AddTargets bulk-adds ts using soft validation: fixable problems (like
+// whitespace in Name) are corrected in place and collected as warnings
+// instead of rejecting the whole batch. It stops at the first hard error,
+// returning it along with the warnings collected so far; targets already
+// added by earlier iterations remain added.
+func (s *Service) AddTargets(ctx context.Context, ts []*platform.ScraperTarget) ([]platform.Warning, error) {
+	var warnings []platform.Warning
+	for _, t := range ts {
+		w, err := t.ValidSoft()
+		warnings = append(warnings, w...)
+		if err != nil {
+			return warnings, err
+		}
+		if err := s.AddTarget(ctx, t); err != nil {
+			return warnings, err
+		}
+	}
+	return warnings, nil
+}
+
+// UpdateTarget overwrites an existing scraper target. A rename into a name
+// already in use by another target in the same org fails with EConflict.
+func (s *Service) UpdateTarget(ctx context.Context, t *platform.ScraperTarget) (*platform.ScraperTarget, error) {
+	existing, err := s.FindTargetByID(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolveTargetRefs(ctx, t, s.op("UpdateTarget")); err != nil {
+		return nil, err
+	}
+	if err := platform.ValidateDescription(s.op("UpdateTarget"), t.Description); err != nil {
+		return nil, err
+	}
+	if err := platform.ValidateMetricLists(s.op("UpdateTarget"), t.MetricAllowlist, t.MetricDenylist); err != nil {
+		return nil, err
+	}
+	if err := platform.ValidateHeaders(s.op("UpdateTarget"), t.Headers); err != nil {
+		return nil, err
+	}
+	if s.targetNameInUse(t.OrganizationID, t.Name, &t.ID) {
+		return nil, &platform.Error{
+			Code: platform.EConflict,
+			Msg:  "scraper target with name " + t.Name + " already exists for this organization",
+			Op:   s.op("UpdateTarget"),
+		}
+	}
+	t.Meta.CreatedAt = existing.Meta.CreatedAt
+	t.Meta.UpdatedAt = s.timeGen.Now()
+	s.scraperKV.Store(t.ID, t)
+	s.invalidateName(platform.ScraperResourceType, t.ID)
+	return t, nil
+}
+
+// PatchTarget applies upd onto the stored target with the given ID, leaving
+// any nil field unchanged. A rename into a name already in use by another
+// target in the same org fails with EConflict.
+func (s *Service) PatchTarget(ctx context.Context, id platform.ID, upd platform.ScraperTargetUpdate) (*platform.ScraperTarget, error) {
+	t, err := s.FindTargetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *t
+	if upd.Name != nil {
+		updated.Name = *upd.Name
+	}
+	if upd.Description != nil {
+		updated.Description = *upd.Description
+	}
+	if upd.BucketID != nil {
+		updated.BucketID = *upd.BucketID
+	}
+	if upd.URL != nil {
+		updated.URL = *upd.URL
+	}
+	if upd.InsecureSkipVerify != nil {
+		updated.InsecureSkipVerify = *upd.InsecureSkipVerify
+	}
+	if upd.MetricAllowlist != nil {
+		updated.MetricAllowlist = *upd.MetricAllowlist
+	}
+	if upd.MetricDenylist != nil {
+		updated.MetricDenylist = *upd.MetricDenylist
+	}
+	if upd.Headers != nil {
+		updated.Headers = *upd.Headers
+	}
+
+	if err := platform.ValidateName(s.op("PatchTarget"), updated.Name); err != nil {
+		return nil, err
+	}
+	if err := platform.ValidateDescription(s.op("PatchTarget"), updated.Description); err != nil {
+		return nil, err
+	}
+	if err := platform.ValidateMetricLists(s.op("PatchTarget"), updated.MetricAllowlist, updated.MetricDenylist); err != nil {
+		return nil, err
+	}
+	if err := platform.ValidateHeaders(s.op("PatchTarget"), updated.Headers); err != nil {
+		return nil, err
+	}
+	if s.targetNameInUse(updated.OrganizationID, updated.Name, &id) {
+		return nil, &platform.Error{
+			Code: platform.EConflict,
+			Msg:  "scraper target with name " + updated.Name + " already exists for this organization",
+			Op:   s.op("PatchTarget"),
+		}
+	}
+
+	updated.Meta.UpdatedAt = s.timeGen.Now()
+	s.scraperKV.Store(updated.ID, &updated)
+	s.invalidateName(platform.ScraperResourceType, updated.ID)
+	return &updated, nil
+}
+
+// resolveTargetRefs resolves t.OrgName/t.BucketName (if set) to
+// t.OrganizationID/t.BucketID via the organization and bucket stores,
+// clearing the name field once resolved. This closes the race between an
+// out-of-band name-to-ID lookup and a later rename: the resolution happens
+// atomically with the write. An unknown org or bucket name fails with
+// ENotFound.
+func (s *Service) resolveTargetRefs(ctx context.Context, t *platform.ScraperTarget, op string) error {
+	if t.OrgName != "" {
+		orgs, err := s.FindOrganizations(ctx, platform.OrganizationFilter{Name: &t.OrgName})
+		if err != nil {
+			return err
+		}
+		if len(orgs) == 0 {
+			return &platform.Error{
+				Code: platform.ENotFound,
+				Msg:  "organization not found: " + t.OrgName,
+				Op:   op,
+			}
+		}
+		t.OrganizationID = orgs[0].ID
+		t.OrgName = ""
+	}
+
+	if t.BucketName != "" {
+		buckets, _, err := s.FindBuckets(ctx, platform.BucketFilter{OrganizationID: &t.OrganizationID, Name: &t.BucketName}, platform.FindOptions{})
+		if err != nil {
+			return err
+		}
+		if len(buckets) == 0 {
+			return &platform.Error{
+				Code: platform.ENotFound,
+				Msg:  "bucket not found: " + t.BucketName,
+				Op:   op,
+			}
+		}
+		t.BucketID = buckets[0].ID
+		t.BucketName = ""
+	}
+
+	return nil
+}
+
+// targetNameInUse reports whether name is already used by a scraper target
+// in orgID other than excludeID (if non-nil).
+func (s *Service) targetNameInUse(orgID platform.ID, name string, excludeID *platform.ID) bool {
+	inUse := false
+	s.scraperKV.Range(func(k, v interface{}) bool {
+		t := v.(*platform.ScraperTarget)
+		if t.OrganizationID != orgID {
+			return true
+		}
+		if excludeID != nil && t.ID == *excludeID {
+			return true
+		}
+		if t.Name == name {
+			inUse = true
+			return false
+		}
+		return true
+	})
+	return inUse
+}
+
+// GetTargetsByIDs returns the targets for ids, in the same order, with a nil
+// entry wherever an ID has no matching target.
+func (s *Service) GetTargetsByIDs(ctx context.Context, ids []platform.ID) ([]*platform.ScraperTarget, error) {
+	targets := make([]*platform.ScraperTarget, len(ids))
+	for i, id := range ids {
+		v, ok := s.scraperKV.Load(id)
+		if !ok {
+			continue
+		}
+		targets[i] = v.(*platform.ScraperTarget)
+	}
+	return targets, nil
+}
+
+// GetTargetByName returns the scraper target named name within org, or
+// ENotFound if no target in that org has that name.
+func (s *Service) GetTargetByName(ctx context.Context, org platform.ID, name string) (*platform.ScraperTarget, error) {
+	var found *platform.ScraperTarget
+	s.scraperKV.Range(func(k, v interface{}) bool {
+		t := v.(*platform.ScraperTarget)
+		if t.OrganizationID == org && t.Name == name {
+			found = t
+			return false
+		}
+		return true
+	})
+	if found == nil {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "scraper target \"" + name + "\" not found",
+			Op:   s.op("GetTargetByName"),
+		}
+	}
+	return found, nil
+}
+
+// RemoveTarget removes a scraper target by ID.
+func (s *Service) RemoveTarget(ctx context.Context, id platform.ID) error {
+	if _, err := s.FindTargetByID(ctx, id); err != nil {
+		return err
+	}
+	s.scraperKV.Delete(id)
+	s.invalidateName(platform.ScraperResourceType, id)
+	s.targetCountCache.invalidate()
+	return nil
+}
+
+// CountTargets returns the number of scraper targets matching filter,
+// counting in place rather than materializing the matching targets into a
+// slice first. An empty filter counts every target; if the Service was
+// constructed with WithCountCache, that unfiltered count is served from
+// cache for up to the configured TTL rather than recomputed on every call.
+func (s *Service) CountTargets(ctx context.Context, filter platform.ScraperTargetFilter) (int, error) {
+	unfiltered := filter == (platform.ScraperTargetFilter{})
+	if unfiltered {
+		if n, ok := s.targetCountCache.get(); ok {
+			return n, nil
+		}
+	}
+
+	var n int
+	s.scraperKV.Range(func(k, v interface{}) bool {
+		if scraperTargetMatches(v.(*platform.ScraperTarget), filter) {
+			n++
+		}
+		return true
+	})
+
+	if unfiltered {
+		s.targetCountCache.set(n)
+	}
+	return n, nil
+}
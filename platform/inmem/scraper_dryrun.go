@@ -0,0 +1,58 @@
+package inmem
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// DryRunScrape fetches a scraper target's URL and parses the response as
+// whitespace-separated "metric value" lines (comments starting with '#'
+// are skipped), returning the parsed samples without persisting anything.
+// It's meant to let users validate a target before saving it.
+func (s *Service) DryRunScrape(ctx context.Context, target *platform.ScraperTarget) ([]platform.SampleMetric, error) {
+	if s.scraperTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.scraperTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequest(http.MethodGet, target.URL, nil)
+	if err != nil {
+		return nil, &platform.Error{Code: platform.EInvalid, Msg: "invalid scrape URL", Op: s.op("DryRunScrape"), Err: err}
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := s.httpClientFor(target).Do(req)
+	if err != nil {
+		return nil, &platform.Error{Code: platform.EInternal, Msg: "scrape request failed", Op: s.op("DryRunScrape"), Err: err}
+	}
+	defer resp.Body.Close()
+
+	var samples []platform.SampleMetric
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, platform.SampleMetric{Name: fields[0], Value: v})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, &platform.Error{Code: platform.EInternal, Msg: "reading scrape response", Op: s.op("DryRunScrape"), Err: err}
+	}
+
+	return samples, nil
+}
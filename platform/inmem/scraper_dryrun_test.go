@@ -0,0 +1,52 @@
+package inmem_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDryRunScrape(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("cpu_usage 42.5\n# a comment\nmem_usage 10\n"))
+	}))
+	defer srv.Close()
+
+	s := inmem.NewService()
+	target := &platform.ScraperTarget{URL: srv.URL}
+
+	samples, err := s.DryRunScrape(context.Background(), target)
+	if err != nil {
+		t.Fatalf("DryRunScrape: %v", err)
+	}
+	if len(samples) != 2 {
+		t.Fatalf("got %d samples, want 2", len(samples))
+	}
+	if samples[0].Name != "cpu_usage" || samples[0].Value != 42.5 {
+		t.Fatalf("got %+v", samples[0])
+	}
+}
+
+func TestDryRunScrape_TimesOutOnSlowTarget(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("cpu_usage 1\n"))
+	}))
+	defer srv.Close()
+
+	s := inmem.NewService(inmem.WithScraperTimeout(5 * time.Millisecond))
+	target := &platform.ScraperTarget{URL: srv.URL}
+
+	_, err := s.DryRunScrape(context.Background(), target)
+	if err == nil {
+		t.Fatal("got nil error, want a timeout error")
+	}
+	if platform.ErrorCode(err) != platform.EInternal {
+		t.Fatalf("got error code %v, want EInternal", platform.ErrorCode(err))
+	}
+}
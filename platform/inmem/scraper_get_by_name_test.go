@@ -0,0 +1,49 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestGetTargetByName(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := platform.ID(1)
+	target := &platform.ScraperTarget{Name: "prod", OrganizationID: org}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	got, err := s.GetTargetByName(ctx, org, "prod")
+	if err != nil {
+		t.Fatalf("GetTargetByName: %v", err)
+	}
+	if got.ID != target.ID {
+		t.Fatalf("got target %v, want %v", got.ID, target.ID)
+	}
+}
+
+func TestGetTargetByName_NotFound(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := platform.ID(1)
+	if err := s.AddTarget(ctx, &platform.ScraperTarget{Name: "prod", OrganizationID: org}); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	_, err := s.GetTargetByName(ctx, org, "missing")
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got error %v, want ENotFound", err)
+	}
+
+	otherOrg := platform.ID(2)
+	_, err = s.GetTargetByName(ctx, otherOrg, "prod")
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got error %v, want ENotFound for target in a different org", err)
+	}
+}
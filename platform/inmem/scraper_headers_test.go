@@ -0,0 +1,65 @@
+package inmem_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestAddTarget_HeadersRoundTrip(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	target := &platform.ScraperTarget{
+		Name:           "t1",
+		URL:            "http://example.com",
+		OrganizationID: platform.ID(1),
+		Headers:        map[string]string{"X-Scope-OrgID": "tenant-1"},
+	}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	got, err := s.FindTargetByID(ctx, target.ID)
+	if err != nil {
+		t.Fatalf("FindTargetByID: %v", err)
+	}
+	if !reflect.DeepEqual(got.Headers, map[string]string{"X-Scope-OrgID": "tenant-1"}) {
+		t.Fatalf("Headers = %v, want map[X-Scope-OrgID:tenant-1]", got.Headers)
+	}
+}
+
+func TestAddTarget_InvalidHeaderNameRejected(t *testing.T) {
+	s := inmem.NewService()
+	err := s.AddTarget(context.Background(), &platform.ScraperTarget{
+		Name:           "t1",
+		URL:            "http://example.com",
+		OrganizationID: platform.ID(1),
+		Headers:        map[string]string{"bad header name": "1"},
+	})
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("err = %v, want EInvalid", err)
+	}
+}
+
+func TestPatchTarget_Headers(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	target := &platform.ScraperTarget{Name: "t1", URL: "http://example.com", OrganizationID: platform.ID(1)}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	headers := map[string]string{"X-Scope-OrgID": "tenant-2"}
+	updated, err := s.PatchTarget(ctx, target.ID, platform.ScraperTargetUpdate{Headers: &headers})
+	if err != nil {
+		t.Fatalf("PatchTarget: %v", err)
+	}
+	if !reflect.DeepEqual(updated.Headers, headers) {
+		t.Fatalf("Headers = %v, want %v", updated.Headers, headers)
+	}
+}
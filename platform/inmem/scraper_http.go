@@ -0,0 +1,39 @@
+package inmem
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func (s *Service) httpClient() *http.Client {
+	if s.scraperClient != nil {
+		return s.scraperClient
+	}
+	return http.DefaultClient
+}
+
+// httpClientFor returns the client to use for target, wrapping the base
+// client's transport to skip TLS verification when the target requests it.
+func (s *Service) httpClientFor(target *platform.ScraperTarget) *http.Client {
+	base := s.httpClient()
+	if !target.InsecureSkipVerify {
+		return base
+	}
+
+	client := *base
+	transport, ok := base.Transport.(*http.Transport)
+	if !ok || transport == nil {
+		transport = http.DefaultTransport.(*http.Transport)
+	}
+	transport = transport.Clone()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	} else {
+		transport.TLSClientConfig = transport.TLSClientConfig.Clone()
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = true
+	client.Transport = transport
+	return &client
+}
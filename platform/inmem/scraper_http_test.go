@@ -0,0 +1,36 @@
+package inmem_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDryRunScrape_UsesConfiguredHTTPClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("up 1\n"))
+	}))
+	defer srv.Close()
+
+	var used bool
+	client := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		used = true
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+
+	s := inmem.NewService(inmem.WithHTTPClient(client))
+	if _, err := s.DryRunScrape(context.Background(), &platform.ScraperTarget{URL: srv.URL}); err != nil {
+		t.Fatalf("DryRunScrape: %v", err)
+	}
+	if !used {
+		t.Fatalf("configured HTTP client was not used")
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
@@ -0,0 +1,45 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+	"github.com/influxdata/influxdb/platform/mock"
+)
+
+func TestUpdateTarget_PreservesCreatedAtBumpsUpdatedAt(t *testing.T) {
+	clock := &mock.TimeGenerator{FixedTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := inmem.NewService(inmem.WithTimeGenerator(clock))
+	ctx := context.Background()
+
+	target := &platform.ScraperTarget{
+		Name:           "t1",
+		URL:            "http://example.com",
+		OrganizationID: platform.ID(1),
+	}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	createdAt := target.Meta.CreatedAt
+	if createdAt.IsZero() {
+		t.Fatalf("expected CreatedAt to be stamped")
+	}
+
+	clock.FixedTime = time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	target.URL = "http://example.com/updated"
+	updated, err := s.UpdateTarget(ctx, target)
+	if err != nil {
+		t.Fatalf("UpdateTarget: %v", err)
+	}
+
+	if !updated.Meta.CreatedAt.Equal(createdAt) {
+		t.Fatalf("CreatedAt changed: got %v, want %v", updated.Meta.CreatedAt, createdAt)
+	}
+	if updated.Meta.UpdatedAt.Equal(createdAt) {
+		t.Fatalf("UpdatedAt did not change on update")
+	}
+}
@@ -0,0 +1,47 @@
+package inmem_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestAddTarget_MetricAllowlistRoundTrips(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	target := &platform.ScraperTarget{
+		Name:            "t1",
+		URL:             "http://example.com",
+		OrganizationID:  platform.ID(1),
+		MetricAllowlist: []string{"cpu", "mem"},
+	}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	got, err := s.FindTargetByID(ctx, target.ID)
+	if err != nil {
+		t.Fatalf("FindTargetByID: %v", err)
+	}
+	if !reflect.DeepEqual(got.MetricAllowlist, []string{"cpu", "mem"}) {
+		t.Fatalf("MetricAllowlist = %v, want [cpu mem]", got.MetricAllowlist)
+	}
+}
+
+func TestAddTarget_BothAllowAndDenyListsRejected(t *testing.T) {
+	s := inmem.NewService()
+	err := s.AddTarget(context.Background(), &platform.ScraperTarget{
+		Name:            "t1",
+		URL:             "http://example.com",
+		OrganizationID:  platform.ID(1),
+		MetricAllowlist: []string{"cpu"},
+		MetricDenylist:  []string{"mem"},
+	})
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("err = %v, want EInvalid", err)
+	}
+}
@@ -0,0 +1,33 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// RenameTarget renames a scraper target, enforcing name uniqueness within
+// the target's organization.
+func (s *Service) RenameTarget(ctx context.Context, id platform.ID, newName string) (*platform.ScraperTarget, error) {
+	t, err := s.FindTargetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	targets, _, err := s.FindTargets(ctx, platform.ScraperTargetFilter{OrganizationID: &t.OrganizationID}, platform.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range targets {
+		if other.ID != id && other.Name == newName {
+			return nil, &platform.Error{
+				Code: platform.EConflict,
+				Msg:  "scraper target name already in use in this organization",
+				Op:   s.op("RenameTarget"),
+			}
+		}
+	}
+
+	t.Name = newName
+	return s.UpdateTarget(ctx, t)
+}
@@ -0,0 +1,48 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestRenameTarget(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := platform.ID(1)
+	a := &platform.ScraperTarget{Name: "a", OrganizationID: org}
+	b := &platform.ScraperTarget{Name: "b", OrganizationID: org}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if err := s.AddTarget(ctx, b); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	t.Run("successful rename", func(t *testing.T) {
+		got, err := s.RenameTarget(ctx, a.ID, "a-renamed")
+		if err != nil {
+			t.Fatalf("RenameTarget: %v", err)
+		}
+		if got.Name != "a-renamed" {
+			t.Fatalf("Name = %q, want %q", got.Name, "a-renamed")
+		}
+	})
+
+	t.Run("conflicting rename", func(t *testing.T) {
+		_, err := s.RenameTarget(ctx, b.ID, "a-renamed")
+		if platform.ErrorCode(err) != platform.EConflict {
+			t.Fatalf("got %v, want EConflict", err)
+		}
+	})
+
+	t.Run("missing target", func(t *testing.T) {
+		_, err := s.RenameTarget(ctx, 99999, "whatever")
+		if platform.ErrorCode(err) != platform.ENotFound {
+			t.Fatalf("got %v, want ENotFound", err)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestAddTarget_ResolvesOrgAndBucketNames(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := &platform.Organization{Name: "org1"}
+	if err := s.CreateOrganization(ctx, org); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	bucket := &platform.Bucket{Name: "bucket1", OrganizationID: org.ID}
+	if err := s.CreateBucket(ctx, bucket); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+
+	target := &platform.ScraperTarget{Name: "t1", OrgName: "org1", BucketName: "bucket1"}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	if target.OrganizationID != org.ID {
+		t.Fatalf("OrganizationID = %v, want %v", target.OrganizationID, org.ID)
+	}
+	if target.BucketID != bucket.ID {
+		t.Fatalf("BucketID = %v, want %v", target.BucketID, bucket.ID)
+	}
+	if target.OrgName != "" || target.BucketName != "" {
+		t.Fatalf("expected name fields cleared after resolution, got OrgName=%q BucketName=%q", target.OrgName, target.BucketName)
+	}
+}
+
+func TestAddTarget_UnknownOrgNameRejected(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	target := &platform.ScraperTarget{Name: "t1", OrgName: "does-not-exist"}
+	err := s.AddTarget(ctx, target)
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("err = %v, want ENotFound", err)
+	}
+}
+
+func TestAddTarget_UnknownBucketNameRejected(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := &platform.Organization{Name: "org1"}
+	if err := s.CreateOrganization(ctx, org); err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+
+	target := &platform.ScraperTarget{Name: "t1", OrgName: "org1", BucketName: "does-not-exist"}
+	err := s.AddTarget(ctx, target)
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("err = %v, want ENotFound", err)
+	}
+}
@@ -0,0 +1,63 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestAddTargets_TrimsWhitespaceAndWarns(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	ts := []*platform.ScraperTarget{
+		{
+			Name:           "  padded  ",
+			URL:            "http://example.com",
+			OrganizationID: platform.ID(1),
+		},
+	}
+
+	warnings, err := s.AddTargets(ctx, ts)
+	if err != nil {
+		t.Fatalf("AddTargets: %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %+v", len(warnings), warnings)
+	}
+	if warnings[0].Field != "name" {
+		t.Fatalf("warning field = %q, want %q", warnings[0].Field, "name")
+	}
+	if ts[0].Name != "padded" {
+		t.Fatalf("name = %q, want trimmed %q", ts[0].Name, "padded")
+	}
+
+	got, err := s.FindTargetByID(ctx, ts[0].ID)
+	if err != nil {
+		t.Fatalf("FindTargetByID: %v", err)
+	}
+	if got.Name != "padded" {
+		t.Fatalf("stored name = %q, want %q", got.Name, "padded")
+	}
+}
+
+func TestAddTargets_HardErrorStopsBatch(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	ts := []*platform.ScraperTarget{
+		{Name: "ok", URL: "http://example.com", OrganizationID: platform.ID(1)},
+		{Name: "missing-url", OrganizationID: platform.ID(1)},
+	}
+
+	_, err := s.AddTargets(ctx, ts)
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("err = %v, want EInvalid", err)
+	}
+
+	if _, err := s.FindTargetByID(ctx, ts[0].ID); err != nil {
+		t.Fatalf("first target should have been added before the batch stopped: %v", err)
+	}
+}
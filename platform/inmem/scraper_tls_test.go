@@ -0,0 +1,28 @@
+package inmem_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDryRunScrape_InsecureSkipVerify(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("up 1\n"))
+	}))
+	defer srv.Close()
+
+	s := inmem.NewService()
+
+	if _, err := s.DryRunScrape(context.Background(), &platform.ScraperTarget{URL: srv.URL}); err == nil {
+		t.Fatalf("expected TLS verification error without InsecureSkipVerify")
+	}
+
+	if _, err := s.DryRunScrape(context.Background(), &platform.ScraperTarget{URL: srv.URL, InsecureSkipVerify: true}); err != nil {
+		t.Fatalf("DryRunScrape with InsecureSkipVerify: %v", err)
+	}
+}
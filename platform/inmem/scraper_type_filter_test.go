@@ -0,0 +1,32 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindTargets_TypeFilterExcludesOtherTypes(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	prom := &platform.ScraperTarget{Name: "prom", URL: "http://example.com", OrganizationID: platform.ID(1)}
+	if err := s.AddTarget(ctx, prom); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	other := &platform.ScraperTarget{Name: "other", URL: "http://example.com", OrganizationID: platform.ID(1), Type: platform.ScraperType("influx")}
+	if err := s.AddTarget(ctx, other); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	promType := platform.PrometheusScraperType
+	got, n, err := s.FindTargets(ctx, platform.ScraperTargetFilter{Type: &promType}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindTargets: %v", err)
+	}
+	if n != 1 || len(got) != 1 || got[0].ID != prom.ID {
+		t.Fatalf("got %+v, want just the prometheus target", got)
+	}
+}
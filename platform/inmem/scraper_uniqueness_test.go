@@ -0,0 +1,84 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestAddTarget_DuplicateNameSameOrgConflicts(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := platform.ID(1)
+	if err := s.AddTarget(ctx, &platform.ScraperTarget{Name: "prod", OrganizationID: org}); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	err := s.AddTarget(ctx, &platform.ScraperTarget{Name: "prod", OrganizationID: org})
+	if platform.ErrorCode(err) != platform.EConflict {
+		t.Fatalf("got error %v, want EConflict", err)
+	}
+}
+
+func TestAddTarget_DuplicateNameCrossOrgAllowed(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	orgA := platform.ID(1)
+	orgB := platform.ID(2)
+	if err := s.AddTarget(ctx, &platform.ScraperTarget{Name: "prod", OrganizationID: orgA}); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if err := s.AddTarget(ctx, &platform.ScraperTarget{Name: "prod", OrganizationID: orgB}); err != nil {
+		t.Fatalf("AddTarget for a different org should succeed: %v", err)
+	}
+}
+
+func TestUpdateTarget_RenameIntoExistingNameSameOrgConflicts(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := platform.ID(1)
+	a := &platform.ScraperTarget{Name: "a", OrganizationID: org}
+	b := &platform.ScraperTarget{Name: "b", OrganizationID: org}
+	if err := s.AddTarget(ctx, a); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+	if err := s.AddTarget(ctx, b); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	b.Name = "a"
+	_, err := s.UpdateTarget(ctx, b)
+	if platform.ErrorCode(err) != platform.EConflict {
+		t.Fatalf("got error %v, want EConflict", err)
+	}
+}
+
+func TestPatchTarget_UpdatesOnlyGivenField(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	target := &platform.ScraperTarget{
+		Name:           "original",
+		OrganizationID: platform.ID(1),
+		URL:            "http://original.example.com",
+	}
+	if err := s.AddTarget(ctx, target); err != nil {
+		t.Fatalf("AddTarget: %v", err)
+	}
+
+	newName := "renamed"
+	updated, err := s.PatchTarget(ctx, target.ID, platform.ScraperTargetUpdate{Name: &newName})
+	if err != nil {
+		t.Fatalf("PatchTarget: %v", err)
+	}
+	if updated.Name != newName {
+		t.Fatalf("got name %q, want %q", updated.Name, newName)
+	}
+	if updated.URL != "http://original.example.com" {
+		t.Fatalf("got URL %q, want unchanged original URL", updated.URL)
+	}
+}
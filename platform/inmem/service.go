@@ -0,0 +1,180 @@
+// Package inmem provides an in-memory implementation of the platform
+// services, backed by sync.Map. It is primarily intended for tests and
+// small, single-process deployments.
+package inmem
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// Service implements platform's service interfaces entirely in memory.
+type Service struct {
+	idGen platform.IDGenerator
+
+	// timeGen drives every meta-stamping call (CreatedAt/UpdatedAt) across
+	// the Service, defaulting to the wall clock. Override with
+	// WithTimeGenerator for tests that need reproducible timestamps.
+	timeGen platform.TimeGenerator
+
+	// dashboardMu guards read-modify-write sequences against the dashboard
+	// store (update, cell add/move/replace). sync.Map only makes individual
+	// Load/Store calls atomic, not the load-then-store sequences those
+	// operations need.
+	dashboardMu sync.Mutex
+
+	// mu serializes operations that touch more than one resource type
+	// (cascade delete, clone, cell move) so they can't interleave with each
+	// other and observe or leave a half-applied cross-resource state.
+	// Single-resource fast paths (plain Find/Create/Update/Delete on one
+	// sync.Map) don't take mu; they rely on sync.Map's own atomicity, or on
+	// dashboardMu for their own read-modify-write sequence.
+	mu sync.RWMutex
+
+	dashboardKV     sync.Map // map[platform.ID]*platform.Dashboard
+	labelKV         sync.Map // map[platform.ID]*platform.Label
+	organizationKV  sync.Map // map[platform.ID]*platform.Organization
+	bucketKV        sync.Map // map[platform.ID]*platform.Bucket
+	scraperKV       sync.Map // map[platform.ID]*platform.ScraperTarget
+	viewKV          sync.Map // map[platform.ID]*platform.View
+	labelMappingKV  sync.Map // map[labelMappingKey]*platform.LabelMapping
+	userKV          sync.Map // map[platform.ID]*platform.User
+	authorizationKV sync.Map // map[platform.ID]*platform.Authorization
+	// authorizationByToken indexes authorizationKV by Token, so
+	// FindAuthorizationByToken doesn't have to scan every authorization to
+	// resolve the bearer token on each authenticated request.
+	authorizationByToken sync.Map // map[string]platform.ID
+	telegrafKV           sync.Map // map[platform.ID]*platform.TelegrafConfig
+	urmKV                sync.Map // map[urmKey]*platform.UserResourceMapping
+
+	scraperClient *http.Client
+
+	// scraperTimeout, when non-zero, bounds every scraper HTTP request
+	// (e.g. DryRunScrape) with a derived context so a hung target can't
+	// hang the caller forever. Zero preserves today's behavior of using
+	// the caller's context as-is.
+	scraperTimeout time.Duration
+
+	// nameCache caches Service.Name results. Left nil unless configured via
+	// WithNameCache, in which case Name lookups are served from cache and
+	// invalidated by the relevant Update/Delete/Rename operations.
+	nameCache *nameCache
+
+	// viewCache caches FindViewByID results. Left nil unless configured via
+	// WithViewCache, in which case view reads are served from cache and
+	// invalidated by CreateView/UpdateView/DeleteView.
+	viewCache *viewCache
+
+	// dashboardCountCache and targetCountCache cache CountDashboards and
+	// CountTargets respectively for a short TTL. Disabled (ttl 0) unless
+	// configured via WithCountCache, in which case they're invalidated by any mutation
+	// to their resource type (create, delete, and for dashboards, restore
+	// and purge).
+	dashboardCountCache *countCache
+	targetCountCache    *countCache
+}
+
+// ServiceOption configures optional Service behavior at construction time.
+type ServiceOption func(*Service)
+
+// WithHTTPClient configures the *http.Client used for scraper target
+// requests (dry-run scrapes, health checks). Defaults to
+// http.DefaultClient.
+func WithHTTPClient(c *http.Client) ServiceOption {
+	return func(s *Service) {
+		s.scraperClient = c
+	}
+}
+
+// WithScraperTimeout bounds every scraper HTTP request (e.g. DryRunScrape)
+// with a context derived from the caller's, cancelled after timeout. A
+// timeout of 0 (the default) uses the caller's context unmodified.
+func WithScraperTimeout(timeout time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.scraperTimeout = timeout
+	}
+}
+
+// WithIDGenerator overrides the IDGenerator assigning IDs to every resource
+// created without one already set, so tests can substitute a fixed or
+// sequential generator (see the mock package) for the default random one.
+func WithIDGenerator(g platform.IDGenerator) ServiceOption {
+	return func(s *Service) {
+		s.idGen = g
+	}
+}
+
+// WithTimeGenerator overrides the TimeGenerator driving every
+// CreatedAt/UpdatedAt stamp the Service writes. Tests that need
+// reproducible timestamps (e.g. golden files) can pass a mock.TimeGenerator
+// instead of the default wall clock.
+func WithTimeGenerator(g platform.TimeGenerator) ServiceOption {
+	return func(s *Service) {
+		s.timeGen = g
+	}
+}
+
+// WithNameCache opts the Service into an in-process LRU cache of Service.Name
+// results, holding up to size entries for up to ttl before they expire. It
+// is invalidated for a resource whenever that resource is renamed or
+// deleted, so a cached name is never served past the operation that changed
+// it.
+func WithNameCache(size int, ttl time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.nameCache = newNameCache(size, ttl)
+	}
+}
+
+// WithViewCache opts the Service into an in-process, fixed-size LRU cache of
+// FindViewByID results, saving repeated loads when many dashboards share the
+// same view. It is invalidated for a view whenever that view is created,
+// updated, or deleted.
+func WithViewCache(size int) ServiceOption {
+	return func(s *Service) {
+		s.viewCache = newViewCache(size)
+	}
+}
+
+// WithCountCache opts the Service into caching CountDashboards and
+// CountTargets for up to ttl before they're recomputed, since counts
+// requested for UI badges are read far more often than they change. A
+// ttl of 0 disables the cache. Either count is invalidated as soon as a
+// mutation to its resource type occurs, so a cached count is never served
+// past the operation that changed it.
+func WithCountCache(ttl time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.dashboardCountCache = newCountCache(ttl)
+		s.targetCountCache = newCountCache(ttl)
+	}
+}
+
+// OpPrefix identifies this package as the source of an operation in a
+// platform.Error's Op field.
+const OpPrefix = "inmem/"
+
+// op builds an operation name for use in a platform.Error's Op field (or as
+// the op argument to helpers like platform.ValidateName), so every method
+// prefixes consistently instead of concatenating OpPrefix by hand.
+func (s *Service) op(name string) string {
+	return OpPrefix + name
+}
+
+// NewService creates an instance of a Service backed by empty in-memory
+// maps and a default ID generator.
+func NewService(opts ...ServiceOption) *Service {
+	s := &Service{
+		idGen:   NewIDGenerator(),
+		timeGen: platform.RealTimeGenerator{},
+		// Disabled (ttl=0) unless WithCountCache overrides them, so
+		// CountDashboards/CountTargets can call get/set unconditionally.
+		dashboardCountCache: newCountCache(0),
+		targetCountCache:    newCountCache(0),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+package inmem_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+// TestCascadeDeleteConcurrentWithClone runs a dashboard's cascade purge
+// concurrently with a clone of that same dashboard. Run with -race, it
+// verifies the service-wide lock serializes the two cross-resource
+// operations rather than letting them interleave: each op either fully
+// succeeds or fails with ENotFound, never with a partial/corrupted result.
+func TestCascadeDeleteConcurrentWithClone(t *testing.T) {
+	ctx := context.Background()
+	s := inmem.NewService()
+
+	view := &platform.View{Name: "cpu"}
+	if err := s.CreateView(ctx, view); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+	d := &platform.Dashboard{Name: "source", Cells: []*platform.Cell{{ViewID: view.ID}}}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	label := &platform.Label{Name: "env", Key: "env", Value: "prod"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	if err := s.CreateLabelMapping(ctx, &platform.LabelMapping{ResourceID: d.ID, LabelID: label.ID}); err != nil {
+		t.Fatalf("CreateLabelMapping: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	var cloneErr, deleteErr error
+	var clone *platform.Dashboard
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		clone, cloneErr = s.CloneDashboard(ctx, d.ID, "clone")
+	}()
+	go func() {
+		defer wg.Done()
+		deleteErr = s.PurgeDashboard(ctx, d.ID)
+	}()
+	wg.Wait()
+
+	if deleteErr != nil {
+		t.Fatalf("PurgeDashboard: %v", deleteErr)
+	}
+
+	if cloneErr != nil {
+		if platform.ErrorCode(cloneErr) != platform.ENotFound {
+			t.Fatalf("CloneDashboard error = %v, want nil or ENotFound", cloneErr)
+		}
+		return
+	}
+
+	// The clone succeeded: it must be a fully-formed, independent dashboard,
+	// not a half-copied one observed mid-delete.
+	if clone.ID == d.ID {
+		t.Fatalf("clone shares ID with source")
+	}
+	if len(clone.Cells) != len(d.Cells) {
+		t.Fatalf("clone has %d cells, want %d", len(clone.Cells), len(d.Cells))
+	}
+	for _, c := range clone.Cells {
+		if _, err := s.FindViewByID(ctx, c.ViewID); err != nil {
+			t.Fatalf("clone cell references missing view: %v", err)
+		}
+	}
+	if _, err := s.FindDashboardByID(ctx, clone.ID); err != nil {
+		t.Fatalf("clone not retrievable after concurrent delete of source: %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+package inmem
+
+import "github.com/influxdata/influxdb/platform"
+
+// idLess returns the comparison sort.Slice should use to order two
+// platform.IDs ascending, or descending if descending is true. It backs the
+// plain ID-ordered listings (buckets, scraper targets, telegraf configs,
+// label mappings) that don't yet support a SortBy field of their own.
+func idLess(descending bool) func(a, b platform.ID) bool {
+	if descending {
+		return func(a, b platform.ID) bool { return a > b }
+	}
+	return func(a, b platform.ID) bool { return a < b }
+}
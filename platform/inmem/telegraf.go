@@ -0,0 +1,109 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.TelegrafConfigService = (*Service)(nil)
+
+// FindTelegrafConfigByID returns a single Telegraf config by ID.
+func (s *Service) FindTelegrafConfigByID(ctx context.Context, id platform.ID) (*platform.TelegrafConfig, error) {
+	v, ok := s.telegrafKV.Load(id)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "telegraf config not found",
+			Op:   s.op("FindTelegrafConfigByID"),
+		}
+	}
+	return v.(*platform.TelegrafConfig), nil
+}
+
+// FindTelegrafConfigs returns the configs matching filter, along with the
+// total number that matched before opts.Limit/Offset were applied.
+func (s *Service) FindTelegrafConfigs(ctx context.Context, filter platform.TelegrafConfigFilter, opts platform.FindOptions) ([]*platform.TelegrafConfig, int, error) {
+	if err := opts.Valid(); err != nil {
+		return nil, 0, err
+	}
+
+	var configs []*platform.TelegrafConfig
+	s.telegrafKV.Range(func(k, v interface{}) bool {
+		tc := v.(*platform.TelegrafConfig)
+		if filter.OrganizationID != nil && tc.OrganizationID != *filter.OrganizationID {
+			return true
+		}
+		configs = append(configs, tc)
+		return true
+	})
+
+	less := idLess(opts.Descending)
+	sort.Slice(configs, func(i, j int) bool { return less(configs[i].ID, configs[j].ID) })
+
+	total := len(configs)
+
+	if opts.Offset > 0 {
+		if opts.Offset >= len(configs) {
+			return []*platform.TelegrafConfig{}, total, nil
+		}
+		configs = configs[opts.Offset:]
+	}
+
+	if opts.Limit > 0 && opts.Limit < len(configs) {
+		configs = configs[:opts.Limit]
+	}
+
+	return configs, total, nil
+}
+
+// CreateTelegrafConfig creates a new Telegraf config, assigning it an ID if
+// it doesn't already have one.
+func (s *Service) CreateTelegrafConfig(ctx context.Context, tc *platform.TelegrafConfig) error {
+	if err := platform.ValidateName(s.op("CreateTelegrafConfig"), tc.Name); err != nil {
+		return err
+	}
+
+	if !tc.ID.Valid() {
+		tc.ID = s.idGen.ID()
+	}
+	s.telegrafKV.Store(tc.ID, tc)
+	return nil
+}
+
+// UpdateTelegrafConfig overwrites the config with the given ID with tc,
+// failing with ENotFound if it doesn't exist.
+func (s *Service) UpdateTelegrafConfig(ctx context.Context, id platform.ID, tc *platform.TelegrafConfig) (*platform.TelegrafConfig, error) {
+	if _, err := s.FindTelegrafConfigByID(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := platform.ValidateName(s.op("UpdateTelegrafConfig"), tc.Name); err != nil {
+		return nil, err
+	}
+
+	tc.ID = id
+	s.telegrafKV.Store(id, tc)
+	return tc, nil
+}
+
+// DeleteTelegrafConfig removes a Telegraf config by ID, along with any
+// label mappings pointing at it.
+func (s *Service) DeleteTelegrafConfig(ctx context.Context, id platform.ID) error {
+	if _, err := s.FindTelegrafConfigByID(ctx, id); err != nil {
+		return err
+	}
+	s.telegrafKV.Delete(id)
+
+	mappings, _, err := s.FindLabelMappings(ctx, id, platform.FindOptions{})
+	if err != nil {
+		return err
+	}
+	for _, m := range mappings {
+		if err := s.DeleteLabelMapping(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
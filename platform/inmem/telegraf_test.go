@@ -0,0 +1,120 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestUpdateTelegrafConfig_NotFound(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	unknown := platform.ID(999)
+	_, err := s.UpdateTelegrafConfig(ctx, unknown, &platform.TelegrafConfig{Name: "renamed"})
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got err %v, want ENotFound", err)
+	}
+}
+
+func TestUpdateTelegrafConfig_OverwritesExisting(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	tc := &platform.TelegrafConfig{Name: "original", Config: "[[inputs.cpu]]"}
+	if err := s.CreateTelegrafConfig(ctx, tc); err != nil {
+		t.Fatalf("CreateTelegrafConfig: %v", err)
+	}
+
+	updated, err := s.UpdateTelegrafConfig(ctx, tc.ID, &platform.TelegrafConfig{Name: "renamed", Config: "[[inputs.mem]]"})
+	if err != nil {
+		t.Fatalf("UpdateTelegrafConfig: %v", err)
+	}
+	if updated.Name != "renamed" || updated.Config != "[[inputs.mem]]" {
+		t.Fatalf("got %+v, want updated fields applied", updated)
+	}
+
+	got, err := s.FindTelegrafConfigByID(ctx, tc.ID)
+	if err != nil {
+		t.Fatalf("FindTelegrafConfigByID: %v", err)
+	}
+	if got.Name != "renamed" {
+		t.Fatalf("got %+v, want the stored config to reflect the update", got)
+	}
+}
+
+func TestFindTelegrafConfigs_FiltersByOrg(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	orgA := platform.ID(1)
+	orgB := platform.ID(2)
+
+	for _, name := range []string{"a1", "a2"} {
+		if err := s.CreateTelegrafConfig(ctx, &platform.TelegrafConfig{Name: name, OrganizationID: orgA}); err != nil {
+			t.Fatalf("CreateTelegrafConfig: %v", err)
+		}
+	}
+	if err := s.CreateTelegrafConfig(ctx, &platform.TelegrafConfig{Name: "b1", OrganizationID: orgB}); err != nil {
+		t.Fatalf("CreateTelegrafConfig: %v", err)
+	}
+
+	got, total, err := s.FindTelegrafConfigs(ctx, platform.TelegrafConfigFilter{OrganizationID: &orgA}, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindTelegrafConfigs: %v", err)
+	}
+	if total != 2 || len(got) != 2 {
+		t.Fatalf("got %d configs (total=%d), want 2 (total=2)", len(got), total)
+	}
+}
+
+func TestFindTelegrafConfigs_HonorsLimitAndOffset(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	org := platform.ID(1)
+	for _, name := range []string{"a1", "a2", "a3", "a4"} {
+		if err := s.CreateTelegrafConfig(ctx, &platform.TelegrafConfig{Name: name, OrganizationID: org}); err != nil {
+			t.Fatalf("CreateTelegrafConfig: %v", err)
+		}
+	}
+
+	got, total, err := s.FindTelegrafConfigs(ctx, platform.TelegrafConfigFilter{OrganizationID: &org}, platform.FindOptions{Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatalf("FindTelegrafConfigs: %v", err)
+	}
+	if total != 4 || len(got) != 2 {
+		t.Fatalf("got %d configs (total=%d), want 2 (total=4)", len(got), total)
+	}
+}
+
+func TestDeleteTelegrafConfig_CascadesLabelMappings(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	tc := &platform.TelegrafConfig{Name: "cfg"}
+	if err := s.CreateTelegrafConfig(ctx, tc); err != nil {
+		t.Fatalf("CreateTelegrafConfig: %v", err)
+	}
+	label := &platform.Label{Name: "team:x"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	if err := s.CreateLabelMapping(ctx, &platform.LabelMapping{LabelID: label.ID, ResourceID: tc.ID}); err != nil {
+		t.Fatalf("CreateLabelMapping: %v", err)
+	}
+
+	if err := s.DeleteTelegrafConfig(ctx, tc.ID); err != nil {
+		t.Fatalf("DeleteTelegrafConfig: %v", err)
+	}
+
+	mappings, _, err := s.FindLabelMappings(ctx, tc.ID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindLabelMappings: %v", err)
+	}
+	if len(mappings) != 0 {
+		t.Fatalf("got %+v, want no label mappings left", mappings)
+	}
+}
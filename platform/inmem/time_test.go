@@ -0,0 +1,49 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+	"github.com/influxdata/influxdb/platform/mock"
+)
+
+func TestCreateDashboard_UsesInjectedTimeGenerator(t *testing.T) {
+	fixed := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := inmem.NewService(inmem.WithTimeGenerator(&mock.TimeGenerator{FixedTime: fixed}))
+	ctx := context.Background()
+
+	d1 := &platform.Dashboard{Name: "one"}
+	if err := s.CreateDashboard(ctx, d1); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+	d2 := &platform.Dashboard{Name: "two"}
+	if err := s.CreateDashboard(ctx, d2); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	for _, d := range []*platform.Dashboard{d1, d2} {
+		if !d.Meta.CreatedAt.Equal(fixed) {
+			t.Errorf("dashboard %q CreatedAt = %v, want %v", d.Name, d.Meta.CreatedAt, fixed)
+		}
+		if !d.Meta.UpdatedAt.Equal(fixed) {
+			t.Errorf("dashboard %q UpdatedAt = %v, want %v", d.Name, d.Meta.UpdatedAt, fixed)
+		}
+	}
+}
+
+func TestCreateAuthorization_UsesInjectedTimeGenerator(t *testing.T) {
+	fixed := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := inmem.NewService(inmem.WithTimeGenerator(&mock.TimeGenerator{FixedTime: fixed}))
+	ctx := context.Background()
+
+	a := &platform.Authorization{}
+	if err := s.CreateAuthorization(ctx, a); err != nil {
+		t.Fatalf("CreateAuthorization: %v", err)
+	}
+	if !a.CreatedAt.Equal(fixed) {
+		t.Errorf("CreatedAt = %v, want %v", a.CreatedAt, fixed)
+	}
+}
@@ -0,0 +1,84 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// Txn stages writes made by an Apply callback so they can be committed
+// atomically: nothing staged on tx is visible to any other Service method
+// until Apply commits it after fn returns nil.
+type Txn struct {
+	s *Service
+
+	dashboards    map[platform.ID]*platform.Dashboard
+	labelMappings map[labelMappingKey]*platform.LabelMapping
+	urms          map[urmKey]*platform.UserResourceMapping
+}
+
+// PutDashboard validates d, assigns it an ID if it doesn't already have
+// one, stamps its CreatedAt and UpdatedAt, and stages it for creation. d.ID is set before
+// PutDashboard returns, so fn can reference it in later staged writes (for
+// example a label mapping for the new dashboard) before Apply commits
+// anything.
+func (tx *Txn) PutDashboard(d *platform.Dashboard) error {
+	if err := platform.ValidateName(tx.s.op("Apply"), d.Name); err != nil {
+		return err
+	}
+	if !d.ID.Valid() {
+		d.ID = tx.s.idGen.ID()
+	}
+	now := tx.s.timeGen.Now()
+	d.Meta.CreatedAt = now
+	d.Meta.UpdatedAt = now
+	tx.dashboards[d.ID] = d
+	return nil
+}
+
+// PutLabelMapping stages m for creation, associating a label with a
+// resource once Apply commits.
+func (tx *Txn) PutLabelMapping(m *platform.LabelMapping) {
+	key := labelMappingKey{resourceID: m.ResourceID, labelID: m.LabelID}
+	tx.labelMappings[key] = m
+}
+
+// PutUserResourceMapping stages m for creation, granting m.UserID access to
+// m.ResourceID once Apply commits.
+func (tx *Txn) PutUserResourceMapping(m *platform.UserResourceMapping) {
+	key := urmKey{resourceID: m.ResourceID, userID: m.UserID}
+	tx.urms[key] = m
+}
+
+// Apply calls fn with a Txn scoped to s. Every write fn stages on the Txn
+// is buffered in memory only; if fn returns an error, none of it is ever
+// written to s's stores, and Apply returns that error. If fn returns nil,
+// every staged write is applied to s's stores as the final step, so a
+// failure partway through fn can never leave s with, say, a dashboard but
+// none of the label mappings or user-resource mappings fn meant to create
+// alongside it.
+func (s *Service) Apply(ctx context.Context, fn func(tx *Txn) error) error {
+	tx := &Txn{
+		s:             s,
+		dashboards:    make(map[platform.ID]*platform.Dashboard),
+		labelMappings: make(map[labelMappingKey]*platform.LabelMapping),
+		urms:          make(map[urmKey]*platform.UserResourceMapping),
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	for id, d := range tx.dashboards {
+		s.dashboardKV.Store(id, d)
+	}
+	for k, m := range tx.labelMappings {
+		s.labelMappingKV.Store(k, m)
+	}
+	for k, m := range tx.urms {
+		s.urmKV.Store(k, m)
+	}
+	if len(tx.dashboards) > 0 {
+		s.dashboardCountCache.invalidate()
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package inmem_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestApply_CommitsAllStagedWritesTogether(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	label := &platform.Label{Name: "env"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	user := &platform.User{Name: "alice"}
+	if err := s.CreateUser(ctx, user); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	var dashboardID platform.ID
+	err := s.Apply(ctx, func(tx *inmem.Txn) error {
+		d := &platform.Dashboard{Name: "my dashboard"}
+		if err := tx.PutDashboard(d); err != nil {
+			return err
+		}
+		dashboardID = d.ID
+		tx.PutLabelMapping(&platform.LabelMapping{ResourceID: d.ID, LabelID: label.ID})
+		tx.PutUserResourceMapping(&platform.UserResourceMapping{ResourceID: d.ID, UserID: user.ID, UserType: platform.Owner})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	if _, err := s.FindDashboardByID(ctx, dashboardID); err != nil {
+		t.Fatalf("dashboard was not committed: %v", err)
+	}
+	mappings, _, err := s.FindLabelMappings(ctx, dashboardID, platform.FindOptions{})
+	if err != nil || len(mappings) != 1 {
+		t.Fatalf("label mapping was not committed: mappings=%v err=%v", mappings, err)
+	}
+}
+
+func TestApply_StagedFailureLeavesNoWritesApplied(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	label := &platform.Label{Name: "env"}
+	if err := s.CreateLabel(ctx, label); err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+
+	wantErr := errors.New("boom")
+	var dashboardID platform.ID
+	err := s.Apply(ctx, func(tx *inmem.Txn) error {
+		d := &platform.Dashboard{Name: "doomed dashboard"}
+		if err := tx.PutDashboard(d); err != nil {
+			return err
+		}
+		dashboardID = d.ID
+		tx.PutLabelMapping(&platform.LabelMapping{ResourceID: d.ID, LabelID: label.ID})
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	}
+
+	if _, err := s.FindDashboardByID(ctx, dashboardID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("dashboard from a failed Apply should not exist, got err=%v", err)
+	}
+	mappings, _, err := s.FindLabelMappings(ctx, dashboardID, platform.FindOptions{})
+	if err != nil {
+		t.Fatalf("FindLabelMappings: %v", err)
+	}
+	if len(mappings) != 0 {
+		t.Fatalf("label mapping from a failed Apply should not exist, got %v", mappings)
+	}
+}
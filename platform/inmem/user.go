@@ -0,0 +1,132 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.UserService = (*Service)(nil)
+
+// FindUserByID returns a single user by ID.
+func (s *Service) FindUserByID(ctx context.Context, id platform.ID) (*platform.User, error) {
+	v, ok := s.userKV.Load(id)
+	if !ok {
+		return nil, notFound(s.op("FindUserByID"), platform.UserResourceType, id)
+	}
+	return v.(*platform.User), nil
+}
+
+// FindUsers returns all users matching filter.
+func (s *Service) FindUsers(ctx context.Context, filter platform.UserFilter) ([]*platform.User, error) {
+	var users []*platform.User
+	s.userKV.Range(func(k, v interface{}) bool {
+		u := v.(*platform.User)
+		if filter.ID != nil && u.ID != *filter.ID {
+			return true
+		}
+		if filter.Name != nil && u.Name != *filter.Name {
+			return true
+		}
+		users = append(users, u)
+		return true
+	})
+	return users, nil
+}
+
+// FindUser returns the first user matching filter, or ENotFound if none
+// match.
+func (s *Service) FindUser(ctx context.Context, filter platform.UserFilter) (*platform.User, error) {
+	users, err := s.FindUsers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "user not found",
+			Op:   s.op("FindUser"),
+		}
+	}
+	return users[0], nil
+}
+
+// CreateUser creates a new user, assigning it an ID if it doesn't already
+// have one. The name must not already be in use by another user.
+func (s *Service) CreateUser(ctx context.Context, u *platform.User) error {
+	if err := platform.ValidateName(s.op("CreateUser"), u.Name); err != nil {
+		return err
+	}
+
+	if s.userNameInUse(u.Name, nil) {
+		return &platform.Error{
+			Code: platform.EConflict,
+			Msg:  "user with name " + u.Name + " already exists",
+			Op:   s.op("CreateUser"),
+		}
+	}
+
+	if !u.ID.Valid() {
+		u.ID = s.idGen.ID()
+	}
+	s.userKV.Store(u.ID, u)
+	return nil
+}
+
+// UpdateUser applies upd to the user with the given ID. A rename into a
+// name already in use by another user fails with EConflict.
+func (s *Service) UpdateUser(ctx context.Context, id platform.ID, upd platform.UserUpdate) (*platform.User, error) {
+	u, err := s.FindUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if upd.Name != nil {
+		if err := platform.ValidateName(s.op("UpdateUser"), *upd.Name); err != nil {
+			return nil, err
+		}
+		if s.userNameInUse(*upd.Name, &id) {
+			return nil, &platform.Error{
+				Code: platform.EConflict,
+				Msg:  "user with name " + *upd.Name + " already exists",
+				Op:   s.op("UpdateUser"),
+			}
+		}
+		u.Name = *upd.Name
+	}
+
+	s.userKV.Store(u.ID, u)
+	s.invalidateName(platform.UserResourceType, u.ID)
+	return u, nil
+}
+
+// userNameInUse reports whether name is already used by a user other than
+// excludeID (if non-nil). Matching is case-sensitive, matching FindUsers'
+// existing Name behavior.
+func (s *Service) userNameInUse(name string, excludeID *platform.ID) bool {
+	inUse := false
+	s.userKV.Range(func(k, v interface{}) bool {
+		u := v.(*platform.User)
+		if excludeID != nil && u.ID == *excludeID {
+			return true
+		}
+		if u.Name == name {
+			inUse = true
+			return false
+		}
+		return true
+	})
+	return inUse
+}
+
+// DeleteUser removes a user by ID, cascading to every UserResourceMapping
+// granting that user access, so the ID can't be reused later and inherit
+// phantom access from a stale mapping.
+func (s *Service) DeleteUser(ctx context.Context, id platform.ID) error {
+	if _, err := s.FindUserByID(ctx, id); err != nil {
+		return err
+	}
+	s.userKV.Delete(id)
+	s.invalidateName(platform.UserResourceType, id)
+	return s.DeleteUserResourceMappingsForUser(ctx, id)
+}
@@ -0,0 +1,47 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDeleteUser_CascadesUserResourceMappings(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	u := &platform.User{Name: "u1"}
+	if err := s.CreateUser(ctx, u); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	for i, rt := range []platform.ResourceType{
+		platform.BucketResourceType,
+		platform.DashboardResourceType,
+		platform.ScraperResourceType,
+	} {
+		m := &platform.UserResourceMapping{
+			UserID:       u.ID,
+			UserType:     platform.Owner,
+			ResourceType: rt,
+			ResourceID:   platform.ID(i + 1),
+		}
+		if err := s.CreateUserResourceMapping(ctx, m); err != nil {
+			t.Fatalf("CreateUserResourceMapping: %v", err)
+		}
+	}
+
+	if err := s.DeleteUser(ctx, u.ID); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+
+	remaining, n, err := s.FindUserResourceMappings(ctx, platform.UserResourceMappingFilter{UserID: &u.ID})
+	if err != nil {
+		t.Fatalf("FindUserResourceMappings: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("got %d remaining mappings, want 0: %+v", n, remaining)
+	}
+}
@@ -0,0 +1,116 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.UserResourceMappingService = (*Service)(nil)
+
+type urmKey struct {
+	resourceID platform.ID
+	userID     platform.ID
+}
+
+// CreateUserResourceMapping stores m, granting m.UserID access to
+// m.ResourceID.
+func (s *Service) CreateUserResourceMapping(ctx context.Context, m *platform.UserResourceMapping) error {
+	key := urmKey{resourceID: m.ResourceID, userID: m.UserID}
+	s.urmKV.Store(key, m)
+	return nil
+}
+
+// DeleteUserResourceMapping removes the mapping between resourceID and
+// userID. It is idempotent: deleting a mapping that's already gone is not
+// an error.
+func (s *Service) DeleteUserResourceMapping(ctx context.Context, resourceID, userID platform.ID) error {
+	key := urmKey{resourceID: resourceID, userID: userID}
+	s.urmKV.Delete(key)
+	return nil
+}
+
+// RemoveUserResourceMapping is DeleteUserResourceMapping's strict
+// counterpart: it returns an ENotFound error instead of silently
+// succeeding if resourceID and userID don't name an existing mapping.
+func (s *Service) RemoveUserResourceMapping(ctx context.Context, resourceID, userID platform.ID) error {
+	key := urmKey{resourceID: resourceID, userID: userID}
+	if _, ok := s.urmKV.Load(key); !ok {
+		return &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "user resource mapping for resource \"" + resourceID.String() + "\" and user \"" + userID.String() + "\" not found",
+			Op:   s.op("RemoveUserResourceMapping"),
+		}
+	}
+	s.urmKV.Delete(key)
+	return nil
+}
+
+// DeleteUserResourceMappingsForUser removes every mapping granting userID
+// access to anything. It keeps going past an individual failure and
+// aggregates every error into a MultiError, so one bad record can't
+// prevent cleanup of the rest.
+func (s *Service) DeleteUserResourceMappingsForUser(ctx context.Context, userID platform.ID) error {
+	var resourceIDs []platform.ID
+	s.urmKV.Range(func(k, v interface{}) bool {
+		if m := v.(*platform.UserResourceMapping); m.UserID == userID {
+			resourceIDs = append(resourceIDs, m.ResourceID)
+		}
+		return true
+	})
+
+	var errs platform.MultiError
+	for _, resourceID := range resourceIDs {
+		if err := s.DeleteUserResourceMapping(ctx, resourceID, userID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs.ErrOrNil()
+}
+
+// FindUserResourceMappings returns the mappings matching filter, along with
+// the total number that matched.
+func (s *Service) FindUserResourceMappings(ctx context.Context, filter platform.UserResourceMappingFilter) ([]*platform.UserResourceMapping, int, error) {
+	var mappings []*platform.UserResourceMapping
+	s.urmKV.Range(func(k, v interface{}) bool {
+		m := v.(*platform.UserResourceMapping)
+		if userResourceMappingMatches(m, filter) {
+			mappings = append(mappings, m)
+		}
+		return true
+	})
+
+	less := idLess(false)
+	sort.Slice(mappings, func(i, j int) bool { return less(mappings[i].ResourceID, mappings[j].ResourceID) })
+
+	return mappings, len(mappings), nil
+}
+
+// userResourceMappingMatches reports whether m satisfies filter. ResourceType
+// and Resources both narrow by resource type and are combined with OR; see
+// UserResourceMappingFilter's doc comment.
+func userResourceMappingMatches(m *platform.UserResourceMapping, filter platform.UserResourceMappingFilter) bool {
+	if filter.UserID != nil && m.UserID != *filter.UserID {
+		return false
+	}
+	if filter.UserType != nil && m.UserType != *filter.UserType {
+		return false
+	}
+	if filter.ResourceID != nil && m.ResourceID != *filter.ResourceID {
+		return false
+	}
+	if filter.ResourceType != nil || len(filter.Resources) > 0 {
+		matched := filter.ResourceType != nil && m.ResourceType == *filter.ResourceType
+		for _, rt := range filter.Resources {
+			if m.ResourceType == rt {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDeleteUserResourceMapping_AbsentIsNoop(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.DeleteUserResourceMapping(ctx, platform.ID(1), platform.ID(2)); err != nil {
+		t.Fatalf("DeleteUserResourceMapping on an absent mapping should return nil, got %v", err)
+	}
+}
+
+func TestRemoveUserResourceMapping_AbsentErrors(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	err := s.RemoveUserResourceMapping(ctx, platform.ID(1), platform.ID(2))
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got error %v, want ENotFound", err)
+	}
+}
+
+func TestRemoveUserResourceMapping_PresentSucceeds(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	m := &platform.UserResourceMapping{
+		UserID: platform.ID(1), UserType: platform.Owner,
+		ResourceType: platform.BucketResourceType, ResourceID: platform.ID(2),
+	}
+	if err := s.CreateUserResourceMapping(ctx, m); err != nil {
+		t.Fatalf("CreateUserResourceMapping: %v", err)
+	}
+
+	if err := s.RemoveUserResourceMapping(ctx, m.ResourceID, m.UserID); err != nil {
+		t.Fatalf("RemoveUserResourceMapping: %v", err)
+	}
+
+	if err := s.RemoveUserResourceMapping(ctx, m.ResourceID, m.UserID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("second RemoveUserResourceMapping should error ENotFound, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindUserResourceMappings_ResourcesFilterMatchesAny(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	userID := platform.ID(1)
+	mappings := []*platform.UserResourceMapping{
+		{UserID: userID, UserType: platform.Owner, ResourceType: platform.BucketResourceType, ResourceID: platform.ID(10)},
+		{UserID: userID, UserType: platform.Owner, ResourceType: platform.DashboardResourceType, ResourceID: platform.ID(11)},
+		{UserID: userID, UserType: platform.Owner, ResourceType: platform.ScraperResourceType, ResourceID: platform.ID(12)},
+	}
+	for _, m := range mappings {
+		if err := s.CreateUserResourceMapping(ctx, m); err != nil {
+			t.Fatalf("CreateUserResourceMapping: %v", err)
+		}
+	}
+
+	got, n, err := s.FindUserResourceMappings(ctx, platform.UserResourceMappingFilter{
+		UserID:    &userID,
+		Resources: []platform.ResourceType{platform.BucketResourceType, platform.DashboardResourceType},
+	})
+	if err != nil {
+		t.Fatalf("FindUserResourceMappings: %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("got %d mappings, want 2", n)
+	}
+	for _, m := range got {
+		if m.ResourceType != platform.BucketResourceType && m.ResourceType != platform.DashboardResourceType {
+			t.Errorf("unexpected resource type %v in result", m.ResourceType)
+		}
+	}
+}
+
+func TestFindUserResourceMappings_SingleResourceTypeStillWorks(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	userID := platform.ID(1)
+	if err := s.CreateUserResourceMapping(ctx, &platform.UserResourceMapping{
+		UserID: userID, UserType: platform.Owner, ResourceType: platform.BucketResourceType, ResourceID: platform.ID(10),
+	}); err != nil {
+		t.Fatalf("CreateUserResourceMapping: %v", err)
+	}
+	if err := s.CreateUserResourceMapping(ctx, &platform.UserResourceMapping{
+		UserID: userID, UserType: platform.Owner, ResourceType: platform.DashboardResourceType, ResourceID: platform.ID(11),
+	}); err != nil {
+		t.Fatalf("CreateUserResourceMapping: %v", err)
+	}
+
+	rt := platform.BucketResourceType
+	got, n, err := s.FindUserResourceMappings(ctx, platform.UserResourceMappingFilter{ResourceType: &rt})
+	if err != nil {
+		t.Fatalf("FindUserResourceMappings: %v", err)
+	}
+	if n != 1 || got[0].ResourceType != platform.BucketResourceType {
+		t.Fatalf("got %+v, want exactly the bucket mapping", got)
+	}
+}
@@ -0,0 +1,121 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindUser_ByName(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	u := &platform.User{Name: "alice"}
+	if err := s.CreateUser(ctx, u); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	name := "alice"
+	got, err := s.FindUser(ctx, platform.UserFilter{Name: &name})
+	if err != nil {
+		t.Fatalf("FindUser: %v", err)
+	}
+	if got.ID != u.ID {
+		t.Fatalf("got ID %v, want %v", got.ID, u.ID)
+	}
+}
+
+func TestFindUser_NotFound(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	name := "nobody"
+	_, err := s.FindUser(ctx, platform.UserFilter{Name: &name})
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got error %v, want ENotFound", err)
+	}
+}
+
+func TestFindUser_NameAndIDCombined(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	alice := &platform.User{Name: "alice"}
+	if err := s.CreateUser(ctx, alice); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	bob := &platform.User{Name: "bob"}
+	if err := s.CreateUser(ctx, bob); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	name := "alice"
+	// Filtering by bob's ID but alice's name should match nothing.
+	_, err := s.FindUser(ctx, platform.UserFilter{ID: &bob.ID, Name: &name})
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got error %v, want ENotFound", err)
+	}
+
+	// Filtering by alice's ID and name should match.
+	got, err := s.FindUser(ctx, platform.UserFilter{ID: &alice.ID, Name: &name})
+	if err != nil {
+		t.Fatalf("FindUser: %v", err)
+	}
+	if got.ID != alice.ID {
+		t.Fatalf("got ID %v, want %v", got.ID, alice.ID)
+	}
+}
+
+func TestCreateUser_DuplicateNameConflicts(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	if err := s.CreateUser(ctx, &platform.User{Name: "alice"}); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	err := s.CreateUser(ctx, &platform.User{Name: "alice"})
+	if platform.ErrorCode(err) != platform.EConflict {
+		t.Fatalf("got error %v, want EConflict", err)
+	}
+}
+
+func TestUpdateUser_RenameIntoExistingNameConflicts(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	alice := &platform.User{Name: "alice"}
+	if err := s.CreateUser(ctx, alice); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	bob := &platform.User{Name: "bob"}
+	if err := s.CreateUser(ctx, bob); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	aliceName := "alice"
+	_, err := s.UpdateUser(ctx, bob.ID, platform.UserUpdate{Name: &aliceName})
+	if platform.ErrorCode(err) != platform.EConflict {
+		t.Fatalf("got error %v, want EConflict", err)
+	}
+}
+
+func TestUpdateUser_RenameToUnusedNameSucceeds(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	u := &platform.User{Name: "alice"}
+	if err := s.CreateUser(ctx, u); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	newName := "alice-renamed"
+	updated, err := s.UpdateUser(ctx, u.ID, platform.UserUpdate{Name: &newName})
+	if err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	if updated.Name != newName {
+		t.Fatalf("got name %q, want %q", updated.Name, newName)
+	}
+}
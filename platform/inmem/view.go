@@ -0,0 +1,149 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+var _ platform.ViewService = (*Service)(nil)
+
+// FindViewByID returns a single view by ID.
+//
+// If the Service was constructed with WithViewCache, results are served
+// from and populated into that cache; it's invalidated by
+// CreateView/UpdateView/DeleteView so a stale view is never served past the
+// operation that changed it.
+func (s *Service) FindViewByID(ctx context.Context, id platform.ID) (*platform.View, error) {
+	if s.viewCache != nil {
+		if v, ok := s.viewCache.get(id); ok {
+			return v, nil
+		}
+	}
+
+	v, ok := s.viewKV.Load(id)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "view not found",
+			Op:   s.op("FindViewByID"),
+		}
+	}
+	view := v.(*platform.View)
+
+	if s.viewCache != nil {
+		s.viewCache.set(id, view)
+	}
+	return view, nil
+}
+
+// FindViews returns the views matching filter, along with the total number
+// that matched. A DashboardID filter is resolved by scanning that
+// dashboard's cells for referenced view IDs.
+func (s *Service) FindViews(ctx context.Context, filter platform.ViewFilter) ([]*platform.View, int, error) {
+	var allowed map[platform.ID]bool
+	if filter.DashboardID != nil {
+		d, err := s.findDashboardByID(ctx, *filter.DashboardID)
+		if err != nil {
+			return nil, 0, err
+		}
+		allowed = make(map[platform.ID]bool, len(d.Cells))
+		for _, c := range d.Cells {
+			allowed[c.ViewID] = true
+		}
+	}
+
+	var views []*platform.View
+	s.viewKV.Range(func(k, v interface{}) bool {
+		view := v.(*platform.View)
+		if allowed != nil && !allowed[view.ID] {
+			return true
+		}
+		views = append(views, view)
+		return true
+	})
+
+	sort.Slice(views, func(i, j int) bool { return idLess(false)(views[i].ID, views[j].ID) })
+
+	return views, len(views), nil
+}
+
+// CreateView creates a new view, assigning it an ID if it doesn't already
+// have one.
+func (s *Service) CreateView(ctx context.Context, v *platform.View) error {
+	if !v.ID.Valid() {
+		v.ID = s.idGen.ID()
+	}
+	s.viewKV.Store(v.ID, v)
+	if s.viewCache != nil {
+		s.viewCache.invalidate(v.ID)
+	}
+	return nil
+}
+
+// UpdateView applies upd to the view with the given ID.
+func (s *Service) UpdateView(ctx context.Context, id platform.ID, upd platform.ViewUpdate) (*platform.View, error) {
+	v, ok := s.viewKV.Load(id)
+	if !ok {
+		return nil, &platform.Error{
+			Code: platform.ENotFound,
+			Msg:  "view not found",
+			Op:   s.op("UpdateView"),
+		}
+	}
+	view := v.(*platform.View)
+
+	if upd.Name != nil {
+		view.Name = *upd.Name
+	}
+
+	s.viewKV.Store(view.ID, view)
+	if s.viewCache != nil {
+		s.viewCache.invalidate(view.ID)
+	}
+	s.invalidateName(platform.ViewResourceType, view.ID)
+	return view, nil
+}
+
+// DeleteView removes a view by ID. Unless force is set, it fails with
+// EConflict if any dashboard cell still references the view, rather than
+// leaving that cell pointing at a now-missing view.
+func (s *Service) DeleteView(ctx context.Context, id platform.ID, force bool) error {
+	if _, err := s.FindViewByID(ctx, id); err != nil {
+		return err
+	}
+
+	if !force {
+		if dashboardID, cellID, ok := s.findCellReferencingView(id); ok {
+			return &platform.Error{
+				Code: platform.EConflict,
+				Msg:  "view is referenced by cell " + cellID.String() + " on dashboard " + dashboardID.String(),
+				Op:   s.op("DeleteView"),
+			}
+		}
+	}
+
+	s.viewKV.Delete(id)
+	if s.viewCache != nil {
+		s.viewCache.invalidate(id)
+	}
+	s.invalidateName(platform.ViewResourceType, id)
+	return nil
+}
+
+// findCellReferencingView reports the first dashboard/cell pair whose cell
+// references viewID, if any.
+func (s *Service) findCellReferencingView(viewID platform.ID) (dashboardID, cellID platform.ID, found bool) {
+	s.dashboardKV.Range(func(k, v interface{}) bool {
+		d := v.(*platform.Dashboard)
+		for _, c := range d.Cells {
+			if c.ViewID == viewID {
+				dashboardID, cellID, found = d.ID, c.ID, true
+				return false
+			}
+		}
+		return true
+	})
+	return dashboardID, cellID, found
+}
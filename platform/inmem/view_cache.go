@@ -0,0 +1,92 @@
+package inmem
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// viewCache is a fixed-size, concurrency-safe LRU cache of *platform.View by
+// ID, sitting in front of the view store to save repeated loads when many
+// dashboards share the same view.
+type viewCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[platform.ID]*list.Element
+	order   *list.List // front = most recently used
+}
+
+type viewCacheEntry struct {
+	id   platform.ID
+	view *platform.View
+}
+
+// newViewCache returns a viewCache holding up to size entries. A
+// non-positive size disables caching: get always misses and set is a no-op.
+func newViewCache(size int) *viewCache {
+	return &viewCache{
+		size:    size,
+		entries: make(map[platform.ID]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *viewCache) get(id platform.ID) (*platform.View, bool) {
+	if c.size <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*viewCacheEntry).view, true
+}
+
+func (c *viewCache) set(id platform.ID, v *platform.View) {
+	if c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[id]; ok {
+		el.Value.(*viewCacheEntry).view = v
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&viewCacheEntry{id: id, view: v})
+	c.entries[id] = el
+
+	for len(c.entries) > c.size {
+		oldest := c.order.Back()
+		if oldest == nil {
+			break
+		}
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*viewCacheEntry).id)
+	}
+}
+
+func (c *viewCache) invalidate(id platform.ID) {
+	if c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[id]
+	if !ok {
+		return
+	}
+	c.order.Remove(el)
+	delete(c.entries, id)
+}
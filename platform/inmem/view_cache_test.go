@@ -0,0 +1,59 @@
+package inmem
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestViewCache_GetSetInvalidate(t *testing.T) {
+	c := newViewCache(2)
+	id := platform.ID(1)
+
+	if _, ok := c.get(id); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	v := &platform.View{ID: id, Name: "a"}
+	c.set(id, v)
+
+	got, ok := c.get(id)
+	if !ok || got != v {
+		t.Fatalf("got %+v, %v, want %+v, true", got, ok, v)
+	}
+
+	c.invalidate(id)
+	if _, ok := c.get(id); ok {
+		t.Fatal("expected miss after invalidate")
+	}
+}
+
+func TestViewCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := newViewCache(2)
+	v1 := &platform.View{ID: 1, Name: "one"}
+	v2 := &platform.View{ID: 2, Name: "two"}
+	v3 := &platform.View{ID: 3, Name: "three"}
+
+	c.set(1, v1)
+	c.set(2, v2)
+	c.get(1) // touch 1, making 2 the least recently used
+	c.set(3, v3)
+
+	if _, ok := c.get(2); ok {
+		t.Fatal("expected view 2 to have been evicted")
+	}
+	if _, ok := c.get(1); !ok {
+		t.Fatal("expected view 1 to remain cached")
+	}
+	if _, ok := c.get(3); !ok {
+		t.Fatal("expected view 3 to remain cached")
+	}
+}
+
+func TestViewCache_DisabledWhenSizeNonPositive(t *testing.T) {
+	c := newViewCache(0)
+	c.set(1, &platform.View{ID: 1})
+	if _, ok := c.get(1); ok {
+		t.Fatal("expected disabled cache to never hit")
+	}
+}
@@ -0,0 +1,75 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestDeleteView_RejectsWhenReferencedByCell(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	v := &platform.View{Name: "v1"}
+	if err := s.CreateView(ctx, v); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	d := &platform.Dashboard{
+		Name:  "d1",
+		Cells: []*platform.Cell{{ViewID: v.ID}},
+	}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	err := s.DeleteView(ctx, v.ID, false)
+	if platform.ErrorCode(err) != platform.EConflict {
+		t.Fatalf("err = %v, want EConflict", err)
+	}
+
+	if _, err := s.FindViewByID(ctx, v.ID); err != nil {
+		t.Fatalf("view should still exist: %v", err)
+	}
+}
+
+func TestDeleteView_ForceOverridesSafeguard(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	v := &platform.View{Name: "v1"}
+	if err := s.CreateView(ctx, v); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	d := &platform.Dashboard{
+		Name:  "d1",
+		Cells: []*platform.Cell{{ViewID: v.ID}},
+	}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	if err := s.DeleteView(ctx, v.ID, true); err != nil {
+		t.Fatalf("DeleteView(force=true): %v", err)
+	}
+	if _, err := s.FindViewByID(ctx, v.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("err = %v, want ENotFound", err)
+	}
+}
+
+func TestDeleteView_UnreferencedSucceeds(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	v := &platform.View{Name: "v1"}
+	if err := s.CreateView(ctx, v); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	if err := s.DeleteView(ctx, v.ID, false); err != nil {
+		t.Fatalf("DeleteView: %v", err)
+	}
+}
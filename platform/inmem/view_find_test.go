@@ -0,0 +1,80 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindViews_DashboardFilterReturnsReferencedViews(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	v1 := &platform.View{Name: "v1"}
+	v2 := &platform.View{Name: "v2"}
+	v3 := &platform.View{Name: "v3"}
+	for _, v := range []*platform.View{v1, v2, v3} {
+		if err := s.CreateView(ctx, v); err != nil {
+			t.Fatalf("CreateView: %v", err)
+		}
+	}
+
+	d := &platform.Dashboard{
+		Name: "d1",
+		Cells: []*platform.Cell{
+			{ViewID: v1.ID},
+			{ViewID: v2.ID},
+		},
+	}
+	if err := s.CreateDashboard(ctx, d); err != nil {
+		t.Fatalf("CreateDashboard: %v", err)
+	}
+
+	views, n, err := s.FindViews(ctx, platform.ViewFilter{DashboardID: &d.ID})
+	if err != nil {
+		t.Fatalf("FindViews: %v", err)
+	}
+	if n != 2 || len(views) != 2 {
+		t.Fatalf("got %d views, want 2", n)
+	}
+
+	got := map[platform.ID]bool{views[0].ID: true, views[1].ID: true}
+	if !got[v1.ID] || !got[v2.ID] {
+		t.Fatalf("got views %v, want %v and %v", got, v1.ID, v2.ID)
+	}
+	if got[v3.ID] {
+		t.Fatalf("v3 should not be referenced by dashboard, but was returned")
+	}
+}
+
+func TestFindViews_NoFilterReturnsAll(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	for _, name := range []string{"v1", "v2"} {
+		if err := s.CreateView(ctx, &platform.View{Name: name}); err != nil {
+			t.Fatalf("CreateView: %v", err)
+		}
+	}
+
+	views, n, err := s.FindViews(ctx, platform.ViewFilter{})
+	if err != nil {
+		t.Fatalf("FindViews: %v", err)
+	}
+	if n != 2 || len(views) != 2 {
+		t.Fatalf("got %d views, want 2", n)
+	}
+}
+
+func TestFindViews_UnknownDashboardReturnsNotFound(t *testing.T) {
+	s := inmem.NewService()
+	ctx := context.Background()
+
+	unknown := platform.ID(1)
+	_, _, err := s.FindViews(ctx, platform.ViewFilter{DashboardID: &unknown})
+	if platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("err = %v, want ENotFound", err)
+	}
+}
@@ -0,0 +1,85 @@
+package inmem_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/inmem"
+)
+
+func TestFindViewByID_CacheHitReturnsCachedValue(t *testing.T) {
+	s := inmem.NewService(inmem.WithViewCache(10))
+	ctx := context.Background()
+
+	v := &platform.View{Name: "original"}
+	if err := s.CreateView(ctx, v); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	// Prime the cache.
+	got, err := s.FindViewByID(ctx, v.ID)
+	if err != nil {
+		t.Fatalf("FindViewByID: %v", err)
+	}
+	if got.Name != "original" {
+		t.Fatalf("got %q, want %q", got.Name, "original")
+	}
+
+	// A second read with no intervening store mutation must be served from
+	// the cache: it returns the very pointer the first read cached.
+	got2, err := s.FindViewByID(ctx, v.ID)
+	if err != nil {
+		t.Fatalf("FindViewByID: %v", err)
+	}
+	if got2 != got {
+		t.Fatalf("expected cache hit to return the same cached value, got a different one")
+	}
+}
+
+func TestFindViewByID_UpdateInvalidatesCache(t *testing.T) {
+	s := inmem.NewService(inmem.WithViewCache(10))
+	ctx := context.Background()
+
+	v := &platform.View{Name: "original"}
+	if err := s.CreateView(ctx, v); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+
+	if _, err := s.FindViewByID(ctx, v.ID); err != nil {
+		t.Fatalf("FindViewByID: %v", err)
+	}
+
+	updated := "renamed"
+	if _, err := s.UpdateView(ctx, v.ID, platform.ViewUpdate{Name: &updated}); err != nil {
+		t.Fatalf("UpdateView: %v", err)
+	}
+
+	got, err := s.FindViewByID(ctx, v.ID)
+	if err != nil {
+		t.Fatalf("FindViewByID: %v", err)
+	}
+	if got.Name != "renamed" {
+		t.Fatalf("got %q after update, want %q — stale cache entry served", got.Name, "renamed")
+	}
+}
+
+func TestFindViewByID_DeleteInvalidatesCache(t *testing.T) {
+	s := inmem.NewService(inmem.WithViewCache(10))
+	ctx := context.Background()
+
+	v := &platform.View{Name: "original"}
+	if err := s.CreateView(ctx, v); err != nil {
+		t.Fatalf("CreateView: %v", err)
+	}
+	if _, err := s.FindViewByID(ctx, v.ID); err != nil {
+		t.Fatalf("FindViewByID: %v", err)
+	}
+	if err := s.DeleteView(ctx, v.ID, false); err != nil {
+		t.Fatalf("DeleteView: %v", err)
+	}
+
+	if _, err := s.FindViewByID(ctx, v.ID); platform.ErrorCode(err) != platform.ENotFound {
+		t.Fatalf("got err %v, want ENotFound — deleted view still served from cache", err)
+	}
+}
@@ -0,0 +1,52 @@
+package platform
+
+import "context"
+
+// Label is a resource tag. Beyond its display Name, a Label may carry a
+// structured Key/Value pair (e.g. Key: "env", Value: "prod") so resources
+// can be filtered on label semantics rather than just display name, and a
+// Color (e.g. a hex code) used to render it consistently in the UI's tag
+// picker.
+type Label struct {
+	ID    ID
+	Name  string
+	Key   string
+	Value string
+	Color string
+}
+
+// LabelFilter is a set of criteria for filtering labels. Every non-empty
+// field must match (AND); empty fields match all. A non-empty Key matches
+// labels with that key; a non-empty Value further narrows matches to labels
+// with that key and value.
+type LabelFilter struct {
+	Name  string
+	Color string
+	Key   string
+	Value string
+}
+
+// Matches reports whether l satisfies the filter.
+func (f LabelFilter) Matches(l *Label) bool {
+	if f.Name != "" && l.Name != f.Name {
+		return false
+	}
+	if f.Color != "" && l.Color != f.Color {
+		return false
+	}
+	if f.Key != "" && l.Key != f.Key {
+		return false
+	}
+	if f.Value != "" && l.Value != f.Value {
+		return false
+	}
+	return true
+}
+
+// LabelService represents a service for managing resource labels.
+type LabelService interface {
+	FindLabelByID(ctx context.Context, id ID) (*Label, error)
+	FindLabels(ctx context.Context, filter LabelFilter) ([]*Label, error)
+	CreateLabel(ctx context.Context, l *Label) error
+	DeleteLabel(ctx context.Context, id ID) error
+}
@@ -0,0 +1,23 @@
+package platform
+
+import "context"
+
+// LabelMapping associates a Label with a resource (e.g. a Dashboard).
+type LabelMapping struct {
+	ResourceID ID
+	LabelID    ID
+}
+
+// LabelMappingService manages the association between labels and
+// resources.
+type LabelMappingService interface {
+	CreateLabelMapping(ctx context.Context, m *LabelMapping) error
+	// DeleteLabelMapping removes a mapping. It is idempotent: deleting an
+	// already-absent mapping is not an error, since concurrent deletes of
+	// the same mapping (e.g. during resource deletion) are expected.
+	DeleteLabelMapping(ctx context.Context, m *LabelMapping) error
+	// FindLabelMappings returns the page of mappings for resourceID selected
+	// by opts, along with the total count of matching mappings before
+	// paging is applied.
+	FindLabelMappings(ctx context.Context, resourceID ID, opts FindOptions) ([]*LabelMapping, int, error)
+}
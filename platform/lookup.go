@@ -0,0 +1,57 @@
+package platform
+
+import "context"
+
+// ResourceType identifies the kind of resource an ID belongs to, for
+// services (like LookupService) that operate generically across resource
+// kinds.
+type ResourceType string
+
+// Resource types known to the lookup service.
+const (
+	DashboardResourceType    ResourceType = "dashboard"
+	OrganizationResourceType ResourceType = "org"
+	BucketResourceType       ResourceType = "bucket"
+	LabelResourceType        ResourceType = "label"
+	ScraperResourceType      ResourceType = "scraper"
+	ViewResourceType         ResourceType = "view"
+	UserResourceType         ResourceType = "user"
+)
+
+// AllResourceTypes returns every ResourceType known to this package. It's
+// the single place that enumerates them, so Valid and anything iterating
+// known types (e.g. LookupService callers) stay in sync by construction.
+func AllResourceTypes() []ResourceType {
+	return []ResourceType{
+		DashboardResourceType,
+		OrganizationResourceType,
+		BucketResourceType,
+		LabelResourceType,
+		ScraperResourceType,
+		ViewResourceType,
+		UserResourceType,
+	}
+}
+
+// Valid reports whether t is one of the resource types returned by
+// AllResourceTypes.
+func (t ResourceType) Valid() bool {
+	for _, known := range AllResourceTypes() {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// LookupService resolves a resource's display name from its type and ID,
+// for callers (e.g. audit logging, error messages) that only have an ID and
+// don't want to depend on every individual resource service.
+type LookupService interface {
+	Name(ctx context.Context, resourceType ResourceType, id ID) (string, error)
+
+	// Names resolves the display names of many resources of the same type at
+	// once. IDs that don't resolve to an existing resource are omitted from
+	// the returned map rather than failing the whole call.
+	Names(ctx context.Context, resourceType ResourceType, ids []ID) (map[ID]string, error)
+}
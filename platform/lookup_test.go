@@ -0,0 +1,21 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestAllResourceTypes_AllValid(t *testing.T) {
+	for _, rt := range platform.AllResourceTypes() {
+		if !rt.Valid() {
+			t.Errorf("resource type %q from AllResourceTypes is not Valid", rt)
+		}
+	}
+}
+
+func TestResourceType_ValidRejectsUnknown(t *testing.T) {
+	if platform.ResourceType("bogus").Valid() {
+		t.Error("unknown resource type reported as Valid")
+	}
+}
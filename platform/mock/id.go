@@ -0,0 +1,46 @@
+package mock
+
+import "github.com/influxdata/influxdb/platform"
+
+// IDGenerator is a platform.IDGenerator that always returns the same ID,
+// for tests that need one reproducible ID rather than a real random one.
+type IDGenerator struct {
+	id platform.ID
+}
+
+// NewIDGenerator returns an IDGenerator whose ID method always returns the
+// ID encoded by fixed, an IDLength-character hex string. It panics if fixed
+// isn't a valid ID, since a broken fixture ID is a test-authoring mistake,
+// not a runtime condition callers need to handle.
+func NewIDGenerator(fixed string) *IDGenerator {
+	id, err := platform.IDFromString(fixed)
+	if err != nil {
+		panic("mock: invalid fixed ID " + fixed)
+	}
+	return &IDGenerator{id: *id}
+}
+
+// ID returns the fixed ID this generator was constructed with.
+func (g *IDGenerator) ID() platform.ID {
+	return g.id
+}
+
+// SequenceIDGenerator is a platform.IDGenerator that returns incrementing
+// IDs starting at 1, for tests that create several resources and need each
+// one to get a distinct, predictable ID.
+type SequenceIDGenerator struct {
+	next platform.ID
+}
+
+// NewSequenceIDGenerator returns a SequenceIDGenerator whose first ID call
+// returns 1, then 2, 3, and so on.
+func NewSequenceIDGenerator() *SequenceIDGenerator {
+	return &SequenceIDGenerator{next: 1}
+}
+
+// ID returns the next ID in the sequence.
+func (g *SequenceIDGenerator) ID() platform.ID {
+	id := g.next
+	g.next++
+	return id
+}
@@ -0,0 +1,33 @@
+package mock_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+	"github.com/influxdata/influxdb/platform/mock"
+)
+
+func TestIDGenerator_ReturnsFixedID(t *testing.T) {
+	g := mock.NewIDGenerator("0000000000000064")
+	want := platform.ID(100)
+	if got := g.ID(); got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got := g.ID(); got != want {
+		t.Fatalf("second call got %v, want the same fixed %v", got, want)
+	}
+}
+
+func TestSequenceIDGenerator_ReturnsDistinctIncrementingIDs(t *testing.T) {
+	g := mock.NewSequenceIDGenerator()
+	first := g.ID()
+	second := g.ID()
+	third := g.ID()
+
+	if first == second || second == third || first == third {
+		t.Fatalf("got non-distinct IDs: %v, %v, %v", first, second, third)
+	}
+	if first != platform.ID(1) || second != platform.ID(2) || third != platform.ID(3) {
+		t.Fatalf("got %v, %v, %v, want 1, 2, 3", first, second, third)
+	}
+}
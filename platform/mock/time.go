@@ -0,0 +1,16 @@
+// Package mock provides test doubles for platform interfaces.
+package mock
+
+import "time"
+
+// TimeGenerator is a platform.TimeGenerator that always returns FixedTime,
+// for tests that need reproducible meta timestamps instead of the wall
+// clock.
+type TimeGenerator struct {
+	FixedTime time.Time
+}
+
+// Now returns FixedTime.
+func (g *TimeGenerator) Now() time.Time {
+	return g.FixedTime
+}
@@ -0,0 +1,52 @@
+package platform
+
+import "context"
+
+// Organization is a top-level grouping of buckets, dashboards, and other
+// resources belonging to a single tenant.
+type Organization struct {
+	ID   ID
+	Name string
+}
+
+// OrganizationFilter is a set of criteria for filtering organizations.
+// Every non-nil field must match (AND).
+type OrganizationFilter struct {
+	ID   *ID
+	Name *string
+}
+
+// OrganizationUpdate describes a partial update to an Organization.
+type OrganizationUpdate struct {
+	Name *string
+}
+
+// OrgSummary aggregates per-resource-type counts of resources owned by an
+// organization, so an overview page can render from a single round trip
+// instead of one count call per resource type.
+//
+// UserCount is intentionally absent: this tree has no org-scoped user
+// membership concept yet (users aren't owned by an organization anywhere
+// in the data model), so there's nothing honest to count.
+type OrgSummary struct {
+	DashboardCount      int
+	BucketCount         int
+	TelegrafConfigCount int
+	ScraperTargetCount  int
+}
+
+// OrganizationService represents a service for managing organization data.
+type OrganizationService interface {
+	FindOrganizationByID(ctx context.Context, id ID) (*Organization, error)
+	FindOrganizations(ctx context.Context, filter OrganizationFilter) ([]*Organization, error)
+	CreateOrganization(ctx context.Context, o *Organization) error
+	// UpdateOrganization applies upd to the organization with the given ID.
+	// Renaming to a name already in use by another organization fails with
+	// EConflict.
+	UpdateOrganization(ctx context.Context, id ID, upd OrganizationUpdate) (*Organization, error)
+	DeleteOrganization(ctx context.Context, id ID) error
+
+	// OrgSummary returns aggregate counts of resources owned by the
+	// organization with the given ID, or ENotFound if it doesn't exist.
+	OrgSummary(ctx context.Context, id ID) (OrgSummary, error)
+}
@@ -0,0 +1,260 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ScraperTargetMeta holds metadata about a ScraperTarget that isn't
+// editable via the API.
+type ScraperTargetMeta struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ScraperType identifies the protocol a ScraperTarget speaks.
+type ScraperType string
+
+// Scraper types known to this package. An empty ScraperType on a
+// ScraperTarget is treated as PrometheusScraperType, today's only kind.
+const (
+	PrometheusScraperType ScraperType = "prometheus"
+)
+
+// ScraperTarget is a remote endpoint InfluxDB scrapes for metrics.
+type ScraperTarget struct {
+	ID   ID
+	Name string
+	// Description is a free-text note on why the target exists. Optional.
+	Description string `json:"description,omitempty"`
+	Meta        ScraperTargetMeta
+	// Type identifies the scrape protocol. Empty is treated as
+	// PrometheusScraperType.
+	Type           ScraperType `json:"type,omitempty"`
+	OrganizationID ID
+	BucketID       ID
+	// OrgName and BucketName, if set on a call to AddTarget or UpdateTarget,
+	// are resolved to OrganizationID/BucketID at write time (returning
+	// ENotFound if either name doesn't exist) instead of trusting a
+	// caller-supplied ID that may already be stale by the time it's used.
+	// They are write-only inputs: implementations don't need to persist
+	// them once resolved.
+	OrgName    string `json:"orgName,omitempty"`
+	BucketName string `json:"bucketName,omitempty"`
+	URL        string
+	// InsecureSkipVerify disables TLS certificate verification when
+	// scraping this target. Off by default.
+	InsecureSkipVerify bool
+	// MetricAllowlist, if non-empty, restricts scraping to metrics with
+	// exactly these names. MetricDenylist, if non-empty, excludes metrics
+	// with exactly these names. At most one of the two may be set; empty
+	// lists mean "scrape everything," preserving current behavior.
+	MetricAllowlist []string `json:"metricAllowlist,omitempty"`
+	MetricDenylist  []string `json:"metricDenylist,omitempty"`
+	// Headers are custom HTTP headers sent with every scrape request (e.g.
+	// X-Scope-OrgID for a multi-tenant Cortex/Mimir endpoint). Optional.
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// Valid reports every field-level problem with t at once, rather than
+// stopping at the first one, so a caller sees the whole picture in a single
+// round trip. It returns an EInvalid *Error whose Msg lists each invalid
+// field as "field: problem", joined by "; ", or nil if t is valid.
+func (t *ScraperTarget) Valid() error {
+	var problems []string
+
+	if t.Name == "" {
+		problems = append(problems, "name: required")
+	} else if len(t.Name) > MaxNameLength {
+		problems = append(problems, fmt.Sprintf("name: exceeds maximum length of %d", MaxNameLength))
+	}
+
+	if len(t.Description) > MaxDescriptionLength {
+		problems = append(problems, fmt.Sprintf("description: exceeds maximum length of %d", MaxDescriptionLength))
+	}
+
+	if t.URL == "" {
+		problems = append(problems, "url: required")
+	} else if !strings.HasPrefix(t.URL, "http://") && !strings.HasPrefix(t.URL, "https://") {
+		problems = append(problems, "url: must have http/https scheme")
+	}
+
+	if !t.OrganizationID.Valid() {
+		problems = append(problems, "organizationID: required")
+	}
+
+	if len(t.MetricAllowlist) > 0 && len(t.MetricDenylist) > 0 {
+		problems = append(problems, "metricAllowlist/metricDenylist: only one may be set")
+	}
+
+	for name := range t.Headers {
+		if !validHeaderName(name) {
+			problems = append(problems, fmt.Sprintf("headers: %q is not a valid HTTP header name", name))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &Error{
+		Code: EInvalid,
+		Msg:  strings.Join(problems, "; "),
+		Op:   "ScraperTarget.Valid",
+	}
+}
+
+// ValidSoft is like Valid, but separates fixable problems from hard
+// errors: leading/trailing whitespace in Name is trimmed in place and
+// reported as a Warning rather than rejected, so a bulk import of legacy
+// targets can succeed with visibility instead of failing wholesale on a
+// cosmetic issue. Everything Valid would still reject (missing URL,
+// oversized description, ...) still fails here, after the fixups.
+func (t *ScraperTarget) ValidSoft() ([]Warning, error) {
+	var warnings []Warning
+
+	if trimmed := strings.TrimSpace(t.Name); trimmed != t.Name {
+		warnings = append(warnings, Warning{
+			Field: "name",
+			Msg:   "leading/trailing whitespace trimmed",
+		})
+		t.Name = trimmed
+	}
+
+	if err := t.Valid(); err != nil {
+		return warnings, err
+	}
+	return warnings, nil
+}
+
+// ValidateMetricLists returns an EInvalid *Error if both allowlist and
+// denylist are non-empty — a target may filter metrics one way or the
+// other, not both at once — or nil if at most one is set.
+func ValidateMetricLists(op string, allowlist, denylist []string) error {
+	if len(allowlist) > 0 && len(denylist) > 0 {
+		return &Error{
+			Code: EInvalid,
+			Msg:  "metricAllowlist/metricDenylist: only one may be set",
+			Op:   op,
+		}
+	}
+	return nil
+}
+
+// ValidateHeaders returns an EInvalid *Error naming every key of headers
+// that isn't a well-formed HTTP header name, or nil if headers is empty or
+// every key is well-formed.
+func ValidateHeaders(op string, headers map[string]string) error {
+	var problems []string
+	for name := range headers {
+		if !validHeaderName(name) {
+			problems = append(problems, fmt.Sprintf("headers: %q is not a valid HTTP header name", name))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return &Error{
+		Code: EInvalid,
+		Msg:  strings.Join(problems, "; "),
+		Op:   op,
+	}
+}
+
+// validHeaderName reports whether name is a syntactically valid HTTP header
+// field-name: one or more RFC 7230 "tchar" bytes (letters, digits, and
+// !#$%&'*+-.^_`|~), with no whitespace or separator characters.
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// SampleMetric is a single metric sample parsed from a scrape response.
+type SampleMetric struct {
+	Name  string
+	Value float64
+}
+
+// EffectiveType returns t.Type, or PrometheusScraperType if t.Type is
+// unset.
+func (t *ScraperTarget) EffectiveType() ScraperType {
+	if t.Type == "" {
+		return PrometheusScraperType
+	}
+	return t.Type
+}
+
+// ScraperTargetFilter is a set of criteria for filtering scraper targets.
+// Every non-nil field must match (AND).
+type ScraperTargetFilter struct {
+	OrganizationID *ID
+	BucketID       *ID
+	Name           *string
+	// Type, if set, restricts results to targets whose EffectiveType
+	// equals it.
+	Type *ScraperType
+}
+
+// ScraperTargetUpdate describes a partial update to a ScraperTarget. A nil
+// field means "leave unchanged," so a client can change just one field
+// (e.g. URL) without clobbering the rest with zero values.
+type ScraperTargetUpdate struct {
+	Name               *string
+	Description        *string
+	BucketID           *ID
+	URL                *string
+	InsecureSkipVerify *bool
+	MetricAllowlist    *[]string
+	MetricDenylist     *[]string
+	Headers            *map[string]string
+}
+
+// ScraperTargetStoreService represents a service for managing scraper
+// target data.
+type ScraperTargetStoreService interface {
+	FindTargetByID(ctx context.Context, id ID) (*ScraperTarget, error)
+
+	// FindTargets returns the page of targets matching filter selected by
+	// opts, along with the total count of matching targets before paging is
+	// applied (so a caller can tell it's looking at a partial page).
+	FindTargets(ctx context.Context, filter ScraperTargetFilter, opts FindOptions) ([]*ScraperTarget, int, error)
+
+	// CountTargets returns the number of targets matching filter, without
+	// materializing the matching targets themselves.
+	CountTargets(ctx context.Context, filter ScraperTargetFilter) (int, error)
+	AddTarget(ctx context.Context, t *ScraperTarget) error
+
+	// AddTargets bulk-adds ts using soft validation (see
+	// ScraperTarget.ValidSoft): fixable problems are corrected in place and
+	// collected into the returned warnings rather than rejecting the whole
+	// batch. It stops at the first hard error, returning it alongside the
+	// warnings collected so far; targets already added remain added.
+	AddTargets(ctx context.Context, ts []*ScraperTarget) ([]Warning, error)
+	UpdateTarget(ctx context.Context, t *ScraperTarget) (*ScraperTarget, error)
+
+	// PatchTarget applies upd onto the stored target with the given ID,
+	// leaving any nil field unchanged, and returns the updated target.
+	PatchTarget(ctx context.Context, id ID, upd ScraperTargetUpdate) (*ScraperTarget, error)
+	RemoveTarget(ctx context.Context, id ID) error
+
+	// GetTargetsByIDs returns the targets for ids, in the same order, doing
+	// a direct lookup per ID. An ID with no matching target has a nil entry
+	// at its position rather than failing the whole call.
+	GetTargetsByIDs(ctx context.Context, ids []ID) ([]*ScraperTarget, error)
+
+	// GetTargetByName returns the scraper target named name within org, or
+	// an ENotFound error if org has no target with that name.
+	GetTargetByName(ctx context.Context, org ID, name string) (*ScraperTarget, error)
+}
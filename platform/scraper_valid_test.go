@@ -0,0 +1,37 @@
+package platform_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestScraperTargetValid_ReportsEveryFieldProblem(t *testing.T) {
+	target := &platform.ScraperTarget{
+		URL: "ftp://example.com",
+	}
+
+	err := target.Valid()
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("got error %v, want EInvalid", err)
+	}
+
+	msg := platform.ErrorMessage(err)
+	for _, want := range []string{"name: required", "url: must have http/https scheme", "organizationID: required"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("message %q missing expected field problem %q", msg, want)
+		}
+	}
+}
+
+func TestScraperTargetValid_ValidTargetReturnsNil(t *testing.T) {
+	target := &platform.ScraperTarget{
+		Name:           "prod",
+		OrganizationID: platform.ID(1),
+		URL:            "https://example.com/metrics",
+	}
+	if err := target.Valid(); err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+}
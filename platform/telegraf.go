@@ -0,0 +1,33 @@
+package platform
+
+import "context"
+
+// TelegrafConfig is a named Telegraf agent configuration belonging to an
+// organization.
+type TelegrafConfig struct {
+	ID             ID
+	OrganizationID ID
+	Name           string
+	Config         string
+}
+
+// TelegrafConfigFilter is a set of criteria for filtering Telegraf configs.
+type TelegrafConfigFilter struct {
+	OrganizationID *ID
+}
+
+// TelegrafConfigService represents a service for managing Telegraf
+// configuration data.
+type TelegrafConfigService interface {
+	FindTelegrafConfigByID(ctx context.Context, id ID) (*TelegrafConfig, error)
+	// FindTelegrafConfigs returns the configs matching filter, along with
+	// the total number that matched before opts.Limit/Offset were applied.
+	FindTelegrafConfigs(ctx context.Context, filter TelegrafConfigFilter, opts FindOptions) ([]*TelegrafConfig, int, error)
+	CreateTelegrafConfig(ctx context.Context, tc *TelegrafConfig) error
+	// UpdateTelegrafConfig overwrites the config with the given ID, failing
+	// with ENotFound if it doesn't exist.
+	UpdateTelegrafConfig(ctx context.Context, id ID, tc *TelegrafConfig) (*TelegrafConfig, error)
+	// DeleteTelegrafConfig removes a config by ID, along with any label
+	// mappings pointing at it.
+	DeleteTelegrafConfig(ctx context.Context, id ID) error
+}
@@ -0,0 +1,41 @@
+// Package testing holds helpers shared by tests across the platform tree.
+// It is conventionally imported under the name platformtesting, to avoid
+// colliding with the standard library's testing package.
+package testing
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+// DashboardsEqual reports whether a and b are equal, along with a
+// human-readable diff when they aren't. If ignoreTimes is true, a and b's
+// Meta.CreatedAt and Meta.UpdatedAt are excluded from the comparison, so
+// golden tests don't have to zero them by hand just to compare everything
+// else.
+func DashboardsEqual(a, b *platform.Dashboard, ignoreTimes bool) (bool, string) {
+	if a == nil || b == nil {
+		if a == b {
+			return true, ""
+		}
+		return false, fmt.Sprintf("got %v, want %v", a, b)
+	}
+
+	if !ignoreTimes {
+		if !reflect.DeepEqual(a, b) {
+			return false, fmt.Sprintf("got %+v, want %+v", a, b)
+		}
+		return true, ""
+	}
+
+	ac, bc := *a, *b
+	ac.Meta.CreatedAt, bc.Meta.CreatedAt = time.Time{}, time.Time{}
+	ac.Meta.UpdatedAt, bc.Meta.UpdatedAt = time.Time{}, time.Time{}
+	if !reflect.DeepEqual(ac, bc) {
+		return false, fmt.Sprintf("got %+v, want %+v (CreatedAt/UpdatedAt ignored)", ac, bc)
+	}
+	return true, ""
+}
@@ -0,0 +1,38 @@
+package testing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/platform"
+	platformtesting "github.com/influxdata/influxdb/platform/testing"
+)
+
+func TestDashboardsEqual_IgnoreTimes(t *testing.T) {
+	a := &platform.Dashboard{
+		ID:   platform.ID(1),
+		Name: "my dashboard",
+		Meta: platform.DashboardMeta{CreatedAt: time.Unix(0, 0), UpdatedAt: time.Unix(0, 0)},
+	}
+	b := &platform.Dashboard{
+		ID:   platform.ID(1),
+		Name: "my dashboard",
+		Meta: platform.DashboardMeta{CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+
+	if ok, diff := platformtesting.DashboardsEqual(a, b, false); ok {
+		t.Fatalf("dashboards differing only in timestamps should not be equal without ignoreTimes, got no diff: %s", diff)
+	}
+	if ok, diff := platformtesting.DashboardsEqual(a, b, true); !ok {
+		t.Fatalf("dashboards differing only in timestamps should be equal with ignoreTimes: %s", diff)
+	}
+}
+
+func TestDashboardsEqual_OtherFieldsStillCompared(t *testing.T) {
+	a := &platform.Dashboard{ID: platform.ID(1), Name: "a"}
+	b := &platform.Dashboard{ID: platform.ID(1), Name: "b"}
+
+	if ok, diff := platformtesting.DashboardsEqual(a, b, true); ok {
+		t.Fatalf("dashboards differing in Name should not be equal, got no diff: %s", diff)
+	}
+}
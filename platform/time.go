@@ -0,0 +1,18 @@
+package platform
+
+import "time"
+
+// TimeGenerator returns the current time for meta-stamping newly created or
+// updated resources (e.g. a Dashboard's CreatedAt/UpdatedAt). Swappable, like
+// IDGenerator, so tests can inject a fixed clock instead of the wall clock.
+type TimeGenerator interface {
+	Now() time.Time
+}
+
+// RealTimeGenerator is the default TimeGenerator, backed by time.Now.
+type RealTimeGenerator struct{}
+
+// Now returns the current wall-clock time.
+func (RealTimeGenerator) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,38 @@
+package platform
+
+import "context"
+
+// User is an individual account that can authenticate against the
+// platform.
+type User struct {
+	ID   ID
+	Name string
+}
+
+// UserFilter is a set of criteria for filtering users. Name matching is
+// exact.
+type UserFilter struct {
+	ID   *ID
+	Name *string
+}
+
+// UserUpdate describes a partial update to a User.
+type UserUpdate struct {
+	Name *string
+}
+
+// UserService represents a service for managing user data.
+type UserService interface {
+	FindUserByID(ctx context.Context, id ID) (*User, error)
+	// FindUser returns the first user matching filter, or ENotFound if none
+	// match.
+	FindUser(ctx context.Context, filter UserFilter) (*User, error)
+	FindUsers(ctx context.Context, filter UserFilter) ([]*User, error)
+	// CreateUser fails with EConflict if a user with the same name already
+	// exists.
+	CreateUser(ctx context.Context, u *User) error
+	// UpdateUser applies upd to the user with the given ID. Renaming to a
+	// name already in use by another user fails with EConflict.
+	UpdateUser(ctx context.Context, id ID, upd UserUpdate) (*User, error)
+	DeleteUser(ctx context.Context, id ID) error
+}
@@ -0,0 +1,80 @@
+package platform
+
+import "context"
+
+// UserType is a user's role with respect to a mapped resource.
+type UserType string
+
+// User types known to the mapping system.
+const (
+	Owner  UserType = "owner"
+	Member UserType = "member"
+)
+
+// UserResourceMapping grants a user access to a specific resource: owners
+// can read and write it, members can only read it.
+type UserResourceMapping struct {
+	UserID       ID
+	UserType     UserType
+	ResourceType ResourceType
+	ResourceID   ID
+}
+
+// ToPermissions returns the permissions m grants, each scoped to exactly
+// ResourceID via NewPermissionAtID. An owner mapping yields write and read;
+// a member mapping yields read only.
+func (m UserResourceMapping) ToPermissions() []Permission {
+	read := NewPermissionAtID(m.ResourceID, ReadAction, m.ResourceType)
+	if m.UserType == Owner {
+		return []Permission{NewPermissionAtID(m.ResourceID, WriteAction, m.ResourceType), read}
+	}
+	return []Permission{read}
+}
+
+// String renders m as "<userType> user=<userID> on <resourceType>s:<resourceID>",
+// e.g. "owner user=0000000000000001 on buckets:0000000000000064", for
+// logging and debugging in place of a noisy struct dump.
+func (m UserResourceMapping) String() string {
+	return string(m.UserType) + " user=" + m.UserID.String() + " on " + string(m.ResourceType) + "s:" + m.ResourceID.String()
+}
+
+// UserResourceMappingFilter is a set of criteria for filtering user
+// resource mappings. UserID and ResourceID, when set, must match exactly.
+// ResourceType and Resources both narrow by resource type and are combined
+// with OR: a mapping matches if its type equals ResourceType or is one of
+// Resources, so a caller can keep using the single-ResourceType field while
+// a caller that needs several types at once (e.g. "buckets or dashboards")
+// uses Resources instead.
+type UserResourceMappingFilter struct {
+	UserID       *ID
+	UserType     *UserType
+	ResourceType *ResourceType
+	ResourceID   *ID
+	Resources    []ResourceType
+}
+
+// UserResourceMappingService represents a service for managing user
+// resource mappings.
+type UserResourceMappingService interface {
+	// FindUserResourceMappings returns the mappings matching filter, along
+	// with the total number that matched.
+	FindUserResourceMappings(ctx context.Context, filter UserResourceMappingFilter) ([]*UserResourceMapping, int, error)
+	CreateUserResourceMapping(ctx context.Context, m *UserResourceMapping) error
+
+	// DeleteUserResourceMapping removes the mapping between resourceID and
+	// userID. It is idempotent: deleting a mapping that's already gone is
+	// not an error, which keeps callers like a reconciler's "ensure
+	// removed" step from having to swallow ENotFound themselves.
+	DeleteUserResourceMapping(ctx context.Context, resourceID, userID ID) error
+
+	// RemoveUserResourceMapping is DeleteUserResourceMapping's strict
+	// counterpart, for callers that want to know whether they actually
+	// removed something: it returns an ENotFound error if resourceID and
+	// userID don't name an existing mapping.
+	RemoveUserResourceMapping(ctx context.Context, resourceID, userID ID) error
+
+	// DeleteUserResourceMappingsForUser removes every mapping granting
+	// userID access to anything, so a deleted user's ID can't be reused
+	// later and inherit phantom access from stale mappings.
+	DeleteUserResourceMappingsForUser(ctx context.Context, userID ID) error
+}
@@ -0,0 +1,60 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestUserResourceMapping_ToPermissions(t *testing.T) {
+	id := platform.ID(1)
+
+	owner := platform.UserResourceMapping{
+		UserID:       platform.ID(2),
+		UserType:     platform.Owner,
+		ResourceType: platform.BucketResourceType,
+		ResourceID:   id,
+	}
+
+	perms := owner.ToPermissions()
+	want := []string{"write:bucket:" + id.String(), "read:bucket:" + id.String()}
+	if len(perms) != len(want) {
+		t.Fatalf("got %d permissions, want %d", len(perms), len(want))
+	}
+	for i, p := range perms {
+		if p.String() != want[i] {
+			t.Errorf("perms[%d] = %q, want %q", i, p.String(), want[i])
+		}
+		if p.Resource.ID == nil || *p.Resource.ID != id {
+			t.Errorf("perms[%d] Resource.ID = %v, want %v", i, p.Resource.ID, id)
+		}
+	}
+
+	member := platform.UserResourceMapping{
+		UserID:       platform.ID(2),
+		UserType:     platform.Member,
+		ResourceType: platform.BucketResourceType,
+		ResourceID:   id,
+	}
+	memberPerms := member.ToPermissions()
+	if len(memberPerms) != 1 || memberPerms[0].String() != "read:bucket:"+id.String() {
+		t.Fatalf("member perms = %v, want [read:bucket:%s]", memberPerms, id.String())
+	}
+}
+
+func TestUserResourceMapping_String(t *testing.T) {
+	userID := platform.ID(1)
+	resourceID := platform.ID(0x64)
+
+	owner := platform.UserResourceMapping{UserID: userID, UserType: platform.Owner, ResourceType: platform.BucketResourceType, ResourceID: resourceID}
+	want := "owner user=" + userID.String() + " on buckets:" + resourceID.String()
+	if got := owner.String(); got != want {
+		t.Errorf("owner.String() = %q, want %q", got, want)
+	}
+
+	member := platform.UserResourceMapping{UserID: userID, UserType: platform.Member, ResourceType: platform.BucketResourceType, ResourceID: resourceID}
+	want = "member user=" + userID.String() + " on buckets:" + resourceID.String()
+	if got := member.String(); got != want {
+		t.Errorf("member.String() = %q, want %q", got, want)
+	}
+}
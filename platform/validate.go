@@ -0,0 +1,38 @@
+package platform
+
+import "fmt"
+
+// MaxNameLength is the maximum length, in bytes, allowed for a resource's
+// display name.
+const MaxNameLength = 256
+
+// MaxDescriptionLength is the maximum length, in bytes, allowed for a
+// resource's free-text description.
+const MaxDescriptionLength = 4096
+
+// ValidateName returns an EInvalid *Error if name exceeds MaxNameLength, so
+// every resource type reports an identical, recognizable error for the
+// same mistake.
+func ValidateName(op, name string) error {
+	if len(name) > MaxNameLength {
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("name exceeds maximum length of %d", MaxNameLength),
+			Op:   op,
+		}
+	}
+	return nil
+}
+
+// ValidateDescription returns an EInvalid *Error if description exceeds
+// MaxDescriptionLength.
+func ValidateDescription(op, description string) error {
+	if len(description) > MaxDescriptionLength {
+		return &Error{
+			Code: EInvalid,
+			Msg:  fmt.Sprintf("description exceeds maximum length of %d", MaxDescriptionLength),
+			Op:   op,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package platform_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb/platform"
+)
+
+func TestValidateName(t *testing.T) {
+	if err := platform.ValidateName("op", "short name"); err != nil {
+		t.Fatalf("ValidateName: %v", err)
+	}
+
+	tooLong := strings.Repeat("a", platform.MaxNameLength+1)
+	err := platform.ValidateName("op", tooLong)
+	if platform.ErrorCode(err) != platform.EInvalid {
+		t.Fatalf("got %v, want EInvalid", err)
+	}
+}
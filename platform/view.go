@@ -0,0 +1,38 @@
+package platform
+
+import "context"
+
+// View holds the visualization configuration rendered by a dashboard Cell.
+type View struct {
+	ID   ID
+	Name string
+}
+
+// ViewUpdate describes a partial update to a View.
+type ViewUpdate struct {
+	Name *string
+}
+
+// ViewFilter is a set of criteria for filtering views. Every non-nil field
+// must match (AND).
+type ViewFilter struct {
+	// DashboardID, if set, restricts results to views referenced by at
+	// least one cell of the dashboard with this ID.
+	DashboardID *ID
+}
+
+// ViewService represents a service for managing view data.
+type ViewService interface {
+	FindViewByID(ctx context.Context, id ID) (*View, error)
+
+	// FindViews returns the views matching filter, along with the total
+	// number that matched.
+	FindViews(ctx context.Context, filter ViewFilter) ([]*View, int, error)
+	CreateView(ctx context.Context, v *View) error
+	UpdateView(ctx context.Context, id ID, upd ViewUpdate) (*View, error)
+
+	// DeleteView removes a view by ID. If any dashboard cell still
+	// references it, the delete fails with EConflict unless force is set,
+	// so a view can't be orphaned out from under a dashboard by accident.
+	DeleteView(ctx context.Context, id ID, force bool) error
+}
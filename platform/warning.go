@@ -0,0 +1,10 @@
+package platform
+
+// Warning describes a non-fatal problem noticed while validating a value:
+// the value was accepted, possibly after being adjusted in place, but a
+// caller may still want visibility into it (e.g. to flag a legacy import
+// for review).
+type Warning struct {
+	Field string
+	Msg   string
+}